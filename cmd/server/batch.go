@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"github.com/google/uuid"
+)
+
+// BatchCreateTasks creates many tasks in one transaction
+func (s *server) BatchCreateTasks(ctx context.Context, req *pb.BatchCreateTasksRequest) (*pb.BatchCreateTasksResponse, error) {
+	log.Printf("Received BatchCreateTasks request with %d items (partial_success=%t)", len(req.Requests), req.PartialSuccess)
+
+	createReqs := make([]*models.CreateTaskRequest, len(req.Requests))
+	valid, results, err := validateBatch(len(createReqs), !req.PartialSuccess, func(i int) error {
+		var v models.ValidationError
+		createReqs[i] = models.FromProtoCreateTaskRequest(req.Requests[i], &v)
+		v.Merge(createReqs[i].Validate())
+		v.Merge(s.workflow.CheckStatus(models.FieldStatus, createReqs[i].Status))
+		return v.Err()
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	now := time.Now()
+	tasks := make([]*models.Task, len(valid))
+	for j, i := range valid {
+		tasks[j] = &models.Task{
+			ID:          uuid.New().String(),
+			Title:       createReqs[i].Title,
+			Description: createReqs[i].Description,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Version:     1,
+			DueAt:       createReqs[i].DueAt,
+			RemindAt:    createReqs[i].RemindAt,
+			Priority:    createReqs[i].Priority,
+			ProjectID:   createReqs[i].ProjectID,
+			ParentID:    createReqs[i].ParentID,
+			Recurrence:  createReqs[i].Recurrence,
+			Status:      createReqs[i].Status,
+		}
+	}
+
+	stored, err := s.taskRepo.BatchCreateTasks(ctx, tasks, !req.PartialSuccess)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to create tasks")
+	}
+	mergeBatch(results, valid, stored)
+
+	ids := make([]string, len(results))
+	for j, i := range valid {
+		ids[i] = tasks[j].ID
+	}
+
+	log.Printf("Created %d of %d tasks", countSucceeded(results), len(results))
+	return &pb.BatchCreateTasksResponse{Results: toBatchResults(ctx, ids, results)}, nil
+}
+
+// BatchUpdateTasks updates many tasks in one transaction
+func (s *server) BatchUpdateTasks(ctx context.Context, req *pb.BatchUpdateTasksRequest) (*pb.BatchUpdateTasksResponse, error) {
+	log.Printf("Received BatchUpdateTasks request with %d items (partial_success=%t)", len(req.Requests), req.PartialSuccess)
+
+	updateReqs := make([]*models.UpdateTaskRequest, len(req.Requests))
+	ids := make([]string, len(req.Requests))
+	for i, item := range req.Requests {
+		ids[i] = item.Id
+	}
+
+	valid, results, err := validateBatch(len(updateReqs), !req.PartialSuccess, func(i int) error {
+		var v models.ValidationError
+		updateReqs[i] = models.FromProtoUpdateTaskRequest(req.Requests[i], &v)
+		v.Merge(updateReqs[i].Validate())
+		return v.Err()
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	batch := make([]*models.UpdateTaskRequest, len(valid))
+	for j, i := range valid {
+		batch[j] = updateReqs[i]
+	}
+
+	stored, err := s.taskRepo.BatchUpdateTasks(ctx, batch, !req.PartialSuccess)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to update tasks")
+	}
+	mergeBatch(results, valid, stored)
+
+	log.Printf("Updated %d of %d tasks", countSucceeded(results), len(results))
+	return &pb.BatchUpdateTasksResponse{Results: toBatchResults(ctx, ids, results)}, nil
+}
+
+// BatchDeleteTasks moves many tasks to the trash in one transaction
+func (s *server) BatchDeleteTasks(ctx context.Context, req *pb.BatchDeleteTasksRequest) (*pb.BatchDeleteTasksResponse, error) {
+	log.Printf("Received BatchDeleteTasks request with %d items (partial_success=%t)", len(req.Requests), req.PartialSuccess)
+
+	deleteReqs := make([]*models.DeleteTaskRequest, len(req.Requests))
+	ids := make([]string, len(req.Requests))
+	for i, item := range req.Requests {
+		deleteReqs[i] = models.FromProtoDeleteTaskRequest(item)
+		ids[i] = item.Id
+	}
+
+	valid, results, err := validateBatch(len(deleteReqs), !req.PartialSuccess, func(i int) error {
+		return deleteReqs[i].Validate()
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	batch := make([]*models.DeleteTaskRequest, len(valid))
+	for j, i := range valid {
+		batch[j] = deleteReqs[i]
+	}
+
+	stored, err := s.taskRepo.BatchDeleteTasks(ctx, batch, !req.PartialSuccess)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to delete tasks")
+	}
+	mergeBatch(results, valid, stored)
+
+	log.Printf("Deleted %d of %d tasks", countSucceeded(results), len(results))
+	return &pb.BatchDeleteTasksResponse{Results: toBatchResults(ctx, ids, results)}, nil
+}
+
+// validateBatch checks the batch size and validates each of its n items. In atomic mode
+// every violation is returned at once, prefixed with the item's position; otherwise
+// invalid items get their error in results. valid lists the positions to send to the store.
+func validateBatch(n int, atomic bool, validate func(i int) error) (valid []int, results []models.BatchResult, err error) {
+	if err := models.ValidateBatchSize(n); err != nil {
+		return nil, nil, err
+	}
+
+	var verr models.ValidationError
+	results = make([]models.BatchResult, n)
+	valid = make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if err := validate(i); err != nil {
+			if atomic {
+				verr.AddNested(fmt.Sprintf("requests[%d]", i), err)
+			}
+			results[i].Err = err
+			continue
+		}
+		valid = append(valid, i)
+	}
+	if err := verr.Err(); err != nil {
+		return nil, nil, err
+	}
+	return valid, results, nil
+}
+
+// mergeBatch copies the store's results for the valid items back to their positions
+func mergeBatch(results []models.BatchResult, valid []int, stored []models.BatchResult) {
+	for j, i := range valid {
+		results[i] = stored[j]
+	}
+}
+
+// countSucceeded counts the batch items that did not fail
+func countSucceeded(results []models.BatchResult) int {
+	n := 0
+	for _, result := range results {
+		if result.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// toBatchResults converts batch results to protobuf, one per requested ID
+func toBatchResults(ctx context.Context, ids []string, results []models.BatchResult) []*pb.BatchTaskResult {
+	out := make([]*pb.BatchTaskResult, len(results))
+	for i, result := range results {
+		out[i] = &pb.BatchTaskResult{Id: ids[i]}
+		if result.Err != nil {
+			out[i].Error = toBatchError(ctx, result.Err)
+		} else if result.Task != nil {
+			out[i].Task = result.Task.ToProtoTask()
+		}
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// titleUpdate returns an update request that only renames the task
+func titleUpdate(id, title string, version int64) *pb.UpdateTaskRequest {
+	return &pb.UpdateTaskRequest{Id: id, Title: title, Version: version,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}}
+}
+
+// wantResult fails the test unless result failed with code, or succeeded when code is OK
+func wantResult(t *testing.T, result *pb.BatchTaskResult, id string, code codes.Code) {
+	t.Helper()
+	if result.Id != id {
+		t.Errorf("result for %q, want %q", result.Id, id)
+	}
+	got := codes.OK
+	if result.Error != nil {
+		got = codes.Code(result.Error.Code)
+		if result.Error.Reason == "" || result.Error.Message == "" {
+			t.Errorf("result for %q has error %v without a reason and message", id, result.Error)
+		}
+	}
+	if got != code {
+		t.Errorf("result for %q = %s (%v), want %s", id, got, result.Error, code)
+	}
+}
+
+// wantTitle fails the test unless the task has the title and version
+func wantTitle(t *testing.T, s *server, id, title string, version int64) {
+	t.Helper()
+	resp, err := s.GetTask(context.Background(), &pb.GetTaskRequest{Id: id})
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	if resp.Task.Title != title || resp.Task.Version != version {
+		t.Errorf("task %s = %q at version %d, want %q at version %d", id, resp.Task.Title, resp.Task.Version, title, version)
+	}
+}
+
+func TestBatchAtomicRollsBack(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	first, second := createTestTask(t, s, "first"), createTestTask(t, s, "second")
+
+	_, err := s.BatchUpdateTasks(ctx, &pb.BatchUpdateTasksRequest{Requests: []*pb.UpdateTaskRequest{
+		titleUpdate(first.Id, "changed", first.Version),
+		titleUpdate("missing", "changed", 0),
+	}})
+	wantCode(t, err, codes.NotFound)
+	wantTitle(t, s, first.Id, "first", first.Version)
+
+	_, err = s.BatchDeleteTasks(ctx, &pb.BatchDeleteTasksRequest{Requests: []*pb.DeleteTaskRequest{
+		{Id: first.Id},
+		{Id: second.Id, Version: second.Version + 1},
+	}})
+	wantCode(t, err, codes.Aborted)
+	wantTitle(t, s, first.Id, "first", first.Version)
+
+	_, err = s.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{Requests: []*pb.CreateTaskRequest{
+		{Title: "third"},
+		{Title: "fourth", ProjectId: "missing"},
+	}})
+	wantCode(t, err, codes.NotFound)
+	list, err := s.ListTasks(ctx, &pb.ListTasksRequest{})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 2 {
+		t.Errorf("listed %d tasks after the failed batch create, want 2", len(list.Tasks))
+	}
+}
+
+func TestBatchPartialSuccess(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	first, second := createTestTask(t, s, "first"), createTestTask(t, s, "second")
+
+	resp, err := s.BatchUpdateTasks(ctx, &pb.BatchUpdateTasksRequest{PartialSuccess: true, Requests: []*pb.UpdateTaskRequest{
+		titleUpdate(first.Id, "changed", first.Version),
+		titleUpdate("missing", "changed", 0),
+		titleUpdate(second.Id, "changed", second.Version+1),
+		titleUpdate(second.Id, "", 0),
+	}})
+	if err != nil {
+		t.Fatalf("BatchUpdateTasks: %v", err)
+	}
+	if len(resp.Results) != 4 {
+		t.Fatalf("got %d results, want 4", len(resp.Results))
+	}
+	wantResult(t, resp.Results[0], first.Id, codes.OK)
+	wantResult(t, resp.Results[1], "missing", codes.NotFound)
+	wantResult(t, resp.Results[2], second.Id, codes.Aborted)
+	wantResult(t, resp.Results[3], second.Id, codes.InvalidArgument)
+	if task := resp.Results[0].Task; task == nil || task.Title != "changed" || task.Version != first.Version+1 {
+		t.Errorf("updated task = %v, want it renamed at the next version", task)
+	}
+	wantTitle(t, s, first.Id, "changed", first.Version+1)
+	wantTitle(t, s, second.Id, "second", second.Version)
+
+	created, err := s.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{PartialSuccess: true, Requests: []*pb.CreateTaskRequest{
+		{Title: "third"},
+		{Title: "fourth", ProjectId: "missing"},
+		{Title: "fifth", Status: "archived"},
+	}})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks: %v", err)
+	}
+	if created.Results[0].Task == nil || created.Results[0].Error != nil {
+		t.Errorf("first create = %v, want a task", created.Results[0])
+	}
+	wantResult(t, created.Results[1], created.Results[1].Id, codes.NotFound)
+	wantResult(t, created.Results[2], created.Results[2].Id, codes.InvalidArgument)
+}
+
+func TestBatchDuplicateIDs(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	task := createTestTask(t, s, "task")
+
+	// Items run in order, so the second sees the first's version
+	resp, err := s.BatchUpdateTasks(ctx, &pb.BatchUpdateTasksRequest{PartialSuccess: true, Requests: []*pb.UpdateTaskRequest{
+		titleUpdate(task.Id, "once", task.Version),
+		titleUpdate(task.Id, "twice", task.Version),
+	}})
+	if err != nil {
+		t.Fatalf("BatchUpdateTasks: %v", err)
+	}
+	wantResult(t, resp.Results[0], task.Id, codes.OK)
+	wantResult(t, resp.Results[1], task.Id, codes.Aborted)
+	wantTitle(t, s, task.Id, "once", task.Version+1)
+
+	// Without versions both apply, the last one winning
+	if _, err := s.BatchUpdateTasks(ctx, &pb.BatchUpdateTasksRequest{Requests: []*pb.UpdateTaskRequest{
+		titleUpdate(task.Id, "again", 0),
+		titleUpdate(task.Id, "last", 0),
+	}}); err != nil {
+		t.Fatalf("BatchUpdateTasks: %v", err)
+	}
+	wantTitle(t, s, task.Id, "last", task.Version+3)
+
+	// A task can only be trashed once
+	deleted, err := s.BatchDeleteTasks(ctx, &pb.BatchDeleteTasksRequest{PartialSuccess: true, Requests: []*pb.DeleteTaskRequest{
+		{Id: task.Id},
+		{Id: task.Id},
+	}})
+	if err != nil {
+		t.Fatalf("BatchDeleteTasks: %v", err)
+	}
+	wantResult(t, deleted.Results[0], task.Id, codes.OK)
+	wantResult(t, deleted.Results[1], task.Id, codes.NotFound)
+}
+
+func TestBatchSizeLimit(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	requests := make([]*pb.CreateTaskRequest, models.MaxBatchSize+1)
+	for i := range requests {
+		requests[i] = &pb.CreateTaskRequest{Title: "task"}
+	}
+	for _, partial := range []bool{false, true} {
+		_, err := s.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{Requests: requests, PartialSuccess: partial})
+		wantViolations(t, err, "requests")
+	}
+	_, err := s.BatchDeleteTasks(ctx, &pb.BatchDeleteTasksRequest{})
+	wantViolations(t, err, "requests")
+
+	resp, err := s.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{Requests: requests[:models.MaxBatchSize]})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks of MaxBatchSize items: %v", err)
+	}
+	if len(resp.Results) != models.MaxBatchSize {
+		t.Errorf("got %d results, want %d", len(resp.Results), models.MaxBatchSize)
+	}
+}
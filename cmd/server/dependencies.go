@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// AddDependency makes a task blocked by another until that one is completed
+func (s *server) AddDependency(ctx context.Context, req *pb.AddDependencyRequest) (*pb.AddDependencyResponse, error) {
+	log.Printf("Received AddDependency request: %v", req)
+
+	// Convert protobuf request to internal model
+	depReq := models.FromProtoAddDependencyRequest(req)
+
+	// Validate the request
+	if err := depReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task, err := s.taskRepo.AddDependency(ctx, depReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to add blocker %s to task %s", depReq.BlockerID, depReq.TaskID)
+	}
+
+	log.Printf("Task %s is blocked by task %s", task.ID, depReq.BlockerID)
+	return &pb.AddDependencyResponse{Task: task.ToProtoTask()}, nil
+}
+
+// RemoveDependency stops a task being blocked by another
+func (s *server) RemoveDependency(ctx context.Context, req *pb.RemoveDependencyRequest) (*pb.RemoveDependencyResponse, error) {
+	log.Printf("Received RemoveDependency request: %v", req)
+
+	// Convert protobuf request to internal model
+	depReq := models.FromProtoRemoveDependencyRequest(req)
+
+	// Validate the request
+	if err := depReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task, err := s.taskRepo.RemoveDependency(ctx, depReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to remove blocker %s from task %s", depReq.BlockerID, depReq.TaskID)
+	}
+
+	log.Printf("Task %s is no longer blocked by task %s", task.ID, depReq.BlockerID)
+	return &pb.RemoveDependencyResponse{Task: task.ToProtoTask()}, nil
+}
+
+// ListTasksInDependencyOrder lists tasks with every task after its blockers
+func (s *server) ListTasksInDependencyOrder(ctx context.Context, req *pb.ListTasksInDependencyOrderRequest) (*pb.ListTasksInDependencyOrderResponse, error) {
+	log.Printf("Received ListTasksInDependencyOrder request: %v", req)
+
+	tasks, err := s.taskRepo.ListTasksInDependencyOrder(ctx, models.FromProtoListTasksInDependencyOrderRequest(req))
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list tasks in dependency order")
+	}
+
+	resp := &pb.ListTasksInDependencyOrderResponse{Tasks: make([]*pb.Task, len(tasks))}
+	for i, task := range tasks {
+		resp.Tasks[i] = task.ToProtoTask()
+	}
+	return resp, nil
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the ErrorInfo domain for every error this service returns
+const errorDomain = "tasklist.api"
+
+// Stable ErrorInfo reasons; clients may switch on these
+const (
+	reasonValidationFailed   = "VALIDATION_FAILED"
+	reasonInvalidPageToken   = "INVALID_PAGE_TOKEN"
+	reasonExpiredPageToken   = "EXPIRED_PAGE_TOKEN"
+	reasonTaskNotFound       = "TASK_NOT_FOUND"
+	reasonWebhookNotFound    = "WEBHOOK_NOT_FOUND"
+	reasonLabelNotFound      = "LABEL_NOT_FOUND"
+	reasonLabelExists        = "LABEL_ALREADY_EXISTS"
+	reasonProjectNotFound    = "PROJECT_NOT_FOUND"
+	reasonProjectNotEmpty    = "PROJECT_NOT_EMPTY"
+	reasonParentNotFound     = "PARENT_NOT_FOUND"
+	reasonTaskCycle          = "TASK_CYCLE"
+	reasonBlockerNotFound    = "BLOCKER_NOT_FOUND"
+	reasonDependencyCycle    = "DEPENDENCY_CYCLE"
+	reasonTaskBlocked        = "TASK_BLOCKED"
+	reasonInvalidTransition  = "INVALID_TRANSITION"
+	reasonTaskAlreadyExists  = "TASK_ALREADY_EXISTS"
+	reasonVersionConflict    = "VERSION_CONFLICT"
+	reasonServiceUnavailable = "SERVICE_UNAVAILABLE"
+	reasonCursorExpired      = "CURSOR_EXPIRED"
+	reasonWatcherLagged      = "WATCHER_LAGGED"
+	reasonCanceled           = "REQUEST_CANCELED"
+	reasonDeadlineExceeded   = "DEADLINE_EXCEEDED"
+	reasonInternal           = "INTERNAL"
+)
+
+// reasonMessages are the client-facing messages for each reason. Clients never see the
+// text of the underlying error, which may quote SQL or driver internals.
+var reasonMessages = map[string]string{
+	reasonValidationFailed:   "the request is invalid",
+	reasonInvalidPageToken:   "the page token is invalid or belongs to another query",
+	reasonExpiredPageToken:   "the page token has expired",
+	reasonTaskNotFound:       "task not found",
+	reasonWebhookNotFound:    "webhook not found",
+	reasonLabelNotFound:      "label not found",
+	reasonLabelExists:        "a label with that name already exists",
+	reasonProjectNotFound:    "project not found",
+	reasonProjectNotEmpty:    "the project still has tasks",
+	reasonParentNotFound:     "parent task not found",
+	reasonTaskCycle:          "a task cannot be nested under itself or its subtasks",
+	reasonBlockerNotFound:    "blocking task not found",
+	reasonDependencyCycle:    "the dependency would make tasks block each other",
+	reasonTaskBlocked:        "the task is blocked by open tasks",
+	reasonInvalidTransition:  "the workflow does not allow that status change",
+	reasonTaskAlreadyExists:  "task already exists",
+	reasonVersionConflict:    "the task was changed by another request; reload it and retry",
+	reasonServiceUnavailable: "the service is temporarily unavailable",
+	reasonCursorExpired:      "the cursor is older than the retained changes",
+	reasonWatcherLagged:      "the watcher fell too far behind",
+	reasonCanceled:           "the request was canceled",
+	reasonDeadlineExceeded:   "the deadline was exceeded",
+	reasonInternal:           "internal error",
+}
+
+// errorCode maps store, pagination and context errors to gRPC codes
+func errorCode(err error) codes.Code {
+	var verr *models.ValidationError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, database.ErrNotFound),
+		errors.Is(err, database.ErrWebhookNotFound),
+		errors.Is(err, database.ErrLabelNotFound),
+		errors.Is(err, database.ErrProjectNotFound),
+		errors.Is(err, database.ErrParentNotFound),
+		errors.Is(err, database.ErrBlockerNotFound):
+		return codes.NotFound
+	case errors.Is(err, database.ErrAlreadyExists),
+		errors.Is(err, database.ErrLabelExists):
+		return codes.AlreadyExists
+	case errors.Is(err, database.ErrConflict):
+		return codes.Aborted
+	case errors.Is(err, database.ErrProjectNotEmpty),
+		errors.Is(err, database.ErrTaskCycle),
+		errors.Is(err, database.ErrDependencyCycle),
+		errors.Is(err, database.ErrTaskBlocked),
+		errors.Is(err, database.ErrInvalidTransition):
+		return codes.FailedPrecondition
+	case errors.As(err, &verr),
+		errors.Is(err, database.ErrInvalid),
+		errors.Is(err, pagination.ErrInvalidToken),
+		errors.Is(err, pagination.ErrExpiredToken),
+		errors.Is(err, pagination.ErrScopeMismatch):
+		return codes.InvalidArgument
+	case errors.Is(err, database.ErrUnavailable):
+		return codes.Unavailable
+	case errors.Is(err, database.ErrExpired):
+		return codes.OutOfRange
+	case errors.Is(err, database.ErrLagged):
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// errorReason returns the stable ErrorInfo reason for err
+func errorReason(err error) string {
+	switch {
+	case errors.Is(err, pagination.ErrExpiredToken):
+		return reasonExpiredPageToken
+	case errors.Is(err, pagination.ErrInvalidToken),
+		errors.Is(err, pagination.ErrScopeMismatch):
+		return reasonInvalidPageToken
+	case errors.Is(err, database.ErrWebhookNotFound):
+		return reasonWebhookNotFound
+	case errors.Is(err, database.ErrLabelNotFound):
+		return reasonLabelNotFound
+	case errors.Is(err, database.ErrLabelExists):
+		return reasonLabelExists
+	case errors.Is(err, database.ErrProjectNotFound):
+		return reasonProjectNotFound
+	case errors.Is(err, database.ErrProjectNotEmpty):
+		return reasonProjectNotEmpty
+	case errors.Is(err, database.ErrParentNotFound):
+		return reasonParentNotFound
+	case errors.Is(err, database.ErrTaskCycle):
+		return reasonTaskCycle
+	case errors.Is(err, database.ErrBlockerNotFound):
+		return reasonBlockerNotFound
+	case errors.Is(err, database.ErrDependencyCycle):
+		return reasonDependencyCycle
+	case errors.Is(err, database.ErrTaskBlocked):
+		return reasonTaskBlocked
+	case errors.Is(err, database.ErrInvalidTransition):
+		return reasonInvalidTransition
+	}
+	switch errorCode(err) {
+	case codes.Canceled:
+		return reasonCanceled
+	case codes.DeadlineExceeded:
+		return reasonDeadlineExceeded
+	case codes.NotFound:
+		return reasonTaskNotFound
+	case codes.AlreadyExists:
+		return reasonTaskAlreadyExists
+	case codes.Aborted:
+		return reasonVersionConflict
+	case codes.InvalidArgument:
+		return reasonValidationFailed
+	case codes.Unavailable:
+		return reasonServiceUnavailable
+	case codes.OutOfRange:
+		return reasonCursorExpired
+	case codes.ResourceExhausted:
+		return reasonWatcherLagged
+	default:
+		return reasonInternal
+	}
+}
+
+// errorDetails builds the structured details attached to the status for err
+func errorDetails(ctx context.Context, err error) []protoadapt.MessageV1 {
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: errorReason(err),
+		Domain: errorDomain,
+	}}
+
+	var verr *models.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(verr.Violations))
+		for i, v := range verr.Violations {
+			violations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+				Reason:      v.Reason,
+			}
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	case errors.Is(err, pagination.ErrInvalidToken),
+		errors.Is(err, pagination.ErrExpiredToken),
+		errors.Is(err, pagination.ErrScopeMismatch):
+		details = append(details, &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "page_token",
+			Description: reasonMessages[errorReason(err)],
+			Reason:      errorReason(err),
+		}}})
+	}
+
+	if id := requestIDFromContext(ctx); id != "" {
+		details = append(details, &errdetails.RequestInfo{RequestId: id})
+	}
+	return details
+}
+
+// toStatus converts err into a gRPC status error prefixed with a description of the
+// failed operation, with ErrorInfo, BadRequest and RequestInfo details attached. The
+// error itself is logged; clients get the fixed message for its reason, or only the
+// operation for internal errors.
+func toStatus(ctx context.Context, err error, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	code := errorCode(err)
+	log.Printf("[%s] %s: %v", requestIDFromContext(ctx), msg, err)
+	if code != codes.Internal {
+		msg = fmt.Sprintf("%s: %s", msg, reasonMessages[errorReason(err)])
+	}
+
+	st := status.New(code, msg)
+	detailed, derr := st.WithDetails(errorDetails(ctx, err)...)
+	if derr != nil {
+		log.Printf("failed to attach error details: %v", derr)
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// toBatchError describes a failed batch item with the code, reason and message a unary
+// call for the same item would return. The error itself is only logged.
+func toBatchError(ctx context.Context, err error) *pb.BatchError {
+	log.Printf("[%s] batch item failed: %v", requestIDFromContext(ctx), err)
+	reason := errorReason(err)
+	return &pb.BatchError{Code: int32(errorCode(err)), Reason: reason, Message: reasonMessages[reason]}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorMapping(t *testing.T) {
+	var validation models.ValidationError
+	validation.Add("title", models.ReasonRequired, "title cannot be empty")
+
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{context.Canceled, codes.Canceled, reasonCanceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded, reasonDeadlineExceeded},
+		{database.ErrNotFound, codes.NotFound, reasonTaskNotFound},
+		{database.ErrWebhookNotFound, codes.NotFound, reasonWebhookNotFound},
+		{database.ErrLabelNotFound, codes.NotFound, reasonLabelNotFound},
+		{database.ErrProjectNotFound, codes.NotFound, reasonProjectNotFound},
+		{database.ErrParentNotFound, codes.NotFound, reasonParentNotFound},
+		{database.ErrBlockerNotFound, codes.NotFound, reasonBlockerNotFound},
+		{database.ErrAlreadyExists, codes.AlreadyExists, reasonTaskAlreadyExists},
+		{database.ErrLabelExists, codes.AlreadyExists, reasonLabelExists},
+		{database.ErrConflict, codes.Aborted, reasonVersionConflict},
+		{database.ErrProjectNotEmpty, codes.FailedPrecondition, reasonProjectNotEmpty},
+		{database.ErrTaskCycle, codes.FailedPrecondition, reasonTaskCycle},
+		{database.ErrDependencyCycle, codes.FailedPrecondition, reasonDependencyCycle},
+		{database.ErrTaskBlocked, codes.FailedPrecondition, reasonTaskBlocked},
+		{database.ErrInvalidTransition, codes.FailedPrecondition, reasonInvalidTransition},
+		{validation.Err(), codes.InvalidArgument, reasonValidationFailed},
+		{database.ErrInvalid, codes.InvalidArgument, reasonValidationFailed},
+		{pagination.ErrInvalidToken, codes.InvalidArgument, reasonInvalidPageToken},
+		{pagination.ErrScopeMismatch, codes.InvalidArgument, reasonInvalidPageToken},
+		{pagination.ErrExpiredToken, codes.InvalidArgument, reasonExpiredPageToken},
+		{database.ErrUnavailable, codes.Unavailable, reasonServiceUnavailable},
+		{database.ErrExpired, codes.OutOfRange, reasonCursorExpired},
+		{database.ErrLagged, codes.ResourceExhausted, reasonWatcherLagged},
+		{errors.New("boom"), codes.Internal, reasonInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			// Stores wrap the sentinels with context
+			err := fmt.Errorf("task with ID x: %w", tt.err)
+			if got := errorCode(err); got != tt.code {
+				t.Errorf("errorCode = %s, want %s", got, tt.code)
+			}
+			if got := errorReason(err); got != tt.reason {
+				t.Errorf("errorReason = %s, want %s", got, tt.reason)
+			}
+
+			st := status.Convert(toStatus(context.Background(), err, "failed"))
+			if st.Code() != tt.code {
+				t.Errorf("toStatus code = %s, want %s", st.Code(), tt.code)
+			}
+			if info := errorInfo(st); info == nil || info.Reason != tt.reason || info.Domain != errorDomain {
+				t.Errorf("toStatus ErrorInfo = %v, want reason %s in %s", info, tt.reason, errorDomain)
+			}
+
+			// Clients get the reason's fixed message, never the error text
+			want := "failed: " + reasonMessages[tt.reason]
+			if tt.code == codes.Internal {
+				want = "failed"
+			}
+			if reasonMessages[tt.reason] == "" || st.Message() != want {
+				t.Errorf("toStatus message = %q, want %q", st.Message(), want)
+			}
+			if batch := toBatchError(context.Background(), err); batch.Code != int32(tt.code) ||
+				batch.Reason != tt.reason || batch.Message != reasonMessages[tt.reason] {
+				t.Errorf("toBatchError = %v, want code %d, reason %s and the reason's message", batch, tt.code, tt.reason)
+			}
+		})
+	}
+}
+
+func TestToStatusDetails(t *testing.T) {
+	ctx := audit.WithRequestID(context.Background(), "req-1")
+
+	var validation models.ValidationError
+	validation.Add("title", models.ReasonRequired, "title cannot be empty")
+	st := status.Convert(toStatus(ctx, validation.Err(), "validation failed"))
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	var requestID string
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			violations = append(violations, d.FieldViolations...)
+		case *errdetails.RequestInfo:
+			requestID = d.RequestId
+		}
+	}
+	if len(violations) != 1 || violations[0].Field != "title" || violations[0].Reason != models.ReasonRequired {
+		t.Errorf("BadRequest violations = %v, want one for title", violations)
+	}
+	if requestID != "req-1" {
+		t.Errorf("RequestInfo request ID = %q, want req-1", requestID)
+	}
+
+	// Internal errors keep their text out of the status
+	st = status.Convert(toStatus(ctx, errors.New("pq: secret detail"), "failed to get task"))
+	if st.Message() != "failed to get task" {
+		t.Errorf("internal error message = %q, want the operation only", st.Message())
+	}
+
+	// So do mapped ones, whose text may quote the driver too
+	leaky := fmt.Errorf(`pq: duplicate key value violates unique constraint "labels_name_key": %w`, database.ErrLabelExists)
+	st = status.Convert(toStatus(ctx, leaky, "failed to create label"))
+	if want := "failed to create label: " + reasonMessages[reasonLabelExists]; st.Message() != want {
+		t.Errorf("mapped error message = %q, want %q", st.Message(), want)
+	}
+	if batch := toBatchError(ctx, leaky); strings.Contains(batch.Message, "pq") {
+		t.Errorf("batch error message = %q quotes the driver", batch.Message)
+	}
+
+	// Page token violations describe the reason, not the codec's error
+	st = status.Convert(toStatus(ctx, fmt.Errorf("decode: %w", pagination.ErrScopeMismatch), "invalid page_token"))
+	for _, detail := range st.Details() {
+		if bad, ok := detail.(*errdetails.BadRequest); ok {
+			if v := bad.FieldViolations; len(v) != 1 || v[0].Field != "page_token" ||
+				v[0].Description != reasonMessages[reasonInvalidPageToken] {
+				t.Errorf("page token violations = %v", v)
+			}
+		}
+	}
+}
+
+// errorInfo returns the ErrorInfo detail of st, or nil
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
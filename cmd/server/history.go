@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// historyScope binds ListTaskHistory page tokens to the task they were issued for
+func historyScope(taskID string) string {
+	return "history:" + taskID
+}
+
+// ListTaskHistory lists the audited changes to a task, newest first
+func (s *server) ListTaskHistory(ctx context.Context, req *pb.ListTaskHistoryRequest) (*pb.ListTaskHistoryResponse, error) {
+	log.Printf("Received ListTaskHistory request: %v", req)
+
+	// Convert protobuf request to internal model
+	historyReq := models.FromProtoListTaskHistoryRequest(req)
+
+	// Validate the request
+	if err := historyReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	if historyReq.PageToken != "" {
+		var cursor models.HistoryCursor
+		err := s.pageTokens.Decode(historyReq.PageToken, historyScope(historyReq.TaskID), &cursor)
+		if err == nil && cursor.ID == 0 {
+			err = pagination.ErrInvalidToken
+		}
+		if err != nil {
+			return nil, toStatus(ctx, err, "invalid page_token")
+		}
+		historyReq.Cursor = &cursor
+	}
+
+	history, err := s.taskRepo.ListTaskHistory(ctx, historyReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list history of task %s", historyReq.TaskID)
+	}
+
+	if history.NextCursor != nil {
+		history.NextPageToken, err = s.pageTokens.Encode(*history.NextCursor, historyScope(historyReq.TaskID))
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to encode page token")
+		}
+	}
+
+	return history.ToProtoListTaskHistoryResponse(), nil
+}
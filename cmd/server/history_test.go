@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestListTaskHistory(t *testing.T) {
+	s := newTestServer(t)
+	ctx := audit.WithRequestID(audit.WithActor(context.Background(), "alice"), "req-1")
+
+	created, err := s.CreateTask(ctx, &pb.CreateTaskRequest{Title: "draft"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	task := created.Task
+	if _, err := s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		Id:         task.Id,
+		Title:      "final",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if _, err := s.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: task.Id}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if _, err := s.RestoreTask(ctx, &pb.RestoreTaskRequest{Id: task.Id}); err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+	other := createTestTask(t, s, "unrelated")
+
+	// Page through one entry at a time, newest first
+	var entries []*pb.TaskHistoryEntry
+	req := &pb.ListTaskHistoryRequest{TaskId: task.Id, PageSize: 1}
+	for pages := 0; ; pages++ {
+		if pages > 4 {
+			t.Fatal("paging does not terminate")
+		}
+		resp, err := s.ListTaskHistory(ctx, req)
+		if err != nil {
+			t.Fatalf("ListTaskHistory: %v", err)
+		}
+		if len(resp.Entries) > 1 {
+			t.Fatalf("page has %d entries, want at most 1", len(resp.Entries))
+		}
+		entries = append(entries, resp.Entries...)
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		// Tokens are bound to the task they were issued for
+		_, err = s.ListTaskHistory(ctx, &pb.ListTaskHistoryRequest{TaskId: other.Id, PageToken: resp.NextPageToken})
+		wantCode(t, err, codes.InvalidArgument)
+		req.PageToken = resp.NextPageToken
+	}
+
+	wantTypes := []string{models.ChangeRestored, models.ChangeDeleted, models.ChangeUpdated, models.ChangeCreated}
+	if len(entries) != len(wantTypes) {
+		t.Fatalf("got %d history entries, want %d", len(entries), len(wantTypes))
+	}
+	for i, entry := range entries {
+		if entry.Type != wantTypes[i] || entry.Version != int64(len(entries)-i) {
+			t.Errorf("entry %d is %q at version %d, want %q at version %d",
+				i, entry.Type, entry.Version, wantTypes[i], len(entries)-i)
+		}
+		if entry.TaskId != task.Id || entry.Actor != "alice" || entry.RequestId != "req-1" {
+			t.Errorf("entry %d has task %q, actor %q, request %q", i, entry.TaskId, entry.Actor, entry.RequestId)
+		}
+	}
+
+	restored, deleted, updated, create := entries[0], entries[1], entries[2], entries[3]
+	if change := fieldChange(create, models.FieldTitle); change == nil || change.Before != "" || change.After != "draft" {
+		t.Errorf("create recorded title change %v, want \"\" -> draft", change)
+	}
+	if len(updated.Changes) != 1 || updated.Changes[0].Field != models.FieldTitle ||
+		updated.Changes[0].Before != "draft" || updated.Changes[0].After != "final" {
+		t.Errorf("update recorded %v, want only title draft -> final", updated.Changes)
+	}
+	if len(deleted.Changes) != 1 || deleted.Changes[0].Field != "deleted_at" ||
+		deleted.Changes[0].Before != "" || deleted.Changes[0].After == "" {
+		t.Errorf("delete recorded %v, want only deleted_at being set", deleted.Changes)
+	}
+	if len(restored.Changes) != 1 || restored.Changes[0].Field != "deleted_at" ||
+		restored.Changes[0].Before != deleted.Changes[0].After || restored.Changes[0].After != "" {
+		t.Errorf("restore recorded %v, want only deleted_at being cleared", restored.Changes)
+	}
+
+	_, err = s.ListTaskHistory(ctx, &pb.ListTaskHistoryRequest{})
+	wantViolations(t, err, "task_id")
+}
+
+// fieldChange returns the change to field recorded in entry, or nil
+func fieldChange(entry *pb.TaskHistoryEntry, field string) *pb.FieldChange {
+	for _, change := range entry.Changes {
+		if change.Field == field {
+			return change
+		}
+	}
+	return nil
+}
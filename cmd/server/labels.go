@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"github.com/google/uuid"
+)
+
+// CreateLabel adds a label that can then be attached to tasks
+func (s *server) CreateLabel(ctx context.Context, req *pb.CreateLabelRequest) (*pb.CreateLabelResponse, error) {
+	log.Printf("Received CreateLabel request: %v", req)
+
+	// Convert protobuf request to internal model
+	createReq := models.FromProtoCreateLabelRequest(req)
+
+	// Validate the request
+	if err := createReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	label := &models.Label{
+		ID:        uuid.New().String(),
+		Name:      createReq.Name,
+		Color:     createReq.Color,
+		CreatedAt: time.Now(),
+	}
+	if label.Color == "" {
+		label.Color = models.DefaultLabelColor
+	}
+	if err := s.taskRepo.CreateLabel(ctx, label); err != nil {
+		return nil, toStatus(ctx, err, "failed to create label")
+	}
+
+	log.Printf("Created label with ID: %s", label.ID)
+	return &pb.CreateLabelResponse{Label: label.ToProtoLabel()}, nil
+}
+
+// UpdateLabel renames or recolors a label everywhere it is used
+func (s *server) UpdateLabel(ctx context.Context, req *pb.UpdateLabelRequest) (*pb.UpdateLabelResponse, error) {
+	log.Printf("Received UpdateLabel request: %v", req)
+
+	// Convert protobuf request to internal model
+	updateReq := models.FromProtoUpdateLabelRequest(req)
+
+	// Validate the request
+	if err := updateReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	label, err := s.taskRepo.UpdateLabel(ctx, updateReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to update label %s", updateReq.ID)
+	}
+
+	log.Printf("Updated label with ID: %s", label.ID)
+	return &pb.UpdateLabelResponse{Label: label.ToProtoLabel()}, nil
+}
+
+// DeleteLabel removes a label and takes it off every task
+func (s *server) DeleteLabel(ctx context.Context, req *pb.DeleteLabelRequest) (*pb.DeleteLabelResponse, error) {
+	log.Printf("Received DeleteLabel request: %v", req)
+
+	if req.Id == "" {
+		verr := &models.ValidationError{}
+		verr.Add("id", models.ReasonRequired, "id cannot be empty")
+		return nil, toStatus(ctx, verr, "validation failed")
+	}
+
+	if err := s.taskRepo.DeleteLabel(ctx, req.Id); err != nil {
+		return nil, toStatus(ctx, err, "failed to delete label %s", req.Id)
+	}
+
+	log.Printf("Deleted label with ID: %s", req.Id)
+	return &pb.DeleteLabelResponse{Success: true}, nil
+}
+
+// ListLabels lists every label by name
+func (s *server) ListLabels(ctx context.Context, req *pb.ListLabelsRequest) (*pb.ListLabelsResponse, error) {
+	log.Printf("Received ListLabels request: %v", req)
+
+	labels, err := s.taskRepo.ListLabels(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list labels")
+	}
+
+	resp := &pb.ListLabelsResponse{Labels: make([]*pb.Label, len(labels))}
+	for i, label := range labels {
+		resp.Labels[i] = label.ToProtoLabel()
+	}
+	return resp, nil
+}
+
+// AddTaskLabels attaches labels to a task
+func (s *server) AddTaskLabels(ctx context.Context, req *pb.AddTaskLabelsRequest) (*pb.AddTaskLabelsResponse, error) {
+	log.Printf("Received AddTaskLabels request: %v", req)
+
+	// Convert protobuf request to internal model
+	labelsReq := models.FromProtoAddTaskLabelsRequest(req)
+
+	// Validate the request
+	if err := labelsReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task, err := s.taskRepo.AddTaskLabels(ctx, labelsReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to add labels to task %s", labelsReq.TaskID)
+	}
+
+	log.Printf("Labelled task with ID: %s", task.ID)
+	return &pb.AddTaskLabelsResponse{Task: task.ToProtoTask()}, nil
+}
+
+// RemoveTaskLabels detaches labels from a task; labels it does not have are ignored
+func (s *server) RemoveTaskLabels(ctx context.Context, req *pb.RemoveTaskLabelsRequest) (*pb.RemoveTaskLabelsResponse, error) {
+	log.Printf("Received RemoveTaskLabels request: %v", req)
+
+	// Convert protobuf request to internal model
+	labelsReq := models.FromProtoRemoveTaskLabelsRequest(req)
+
+	// Validate the request
+	if err := labelsReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task, err := s.taskRepo.RemoveTaskLabels(ctx, labelsReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to remove labels from task %s", labelsReq.TaskID)
+	}
+
+	log.Printf("Unlabelled task with ID: %s", task.ID)
+	return &pb.RemoveTaskLabelsResponse{Task: task.ToProtoTask()}, nil
+}
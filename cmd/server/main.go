@@ -2,17 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/Samarth11-A/TaskListAPI/internal/config"
 	"github.com/Samarth11-A/TaskListAPI/internal/database"
 	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
 	pb "github.com/Samarth11-A/TaskList_proto/api"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/reflection"
@@ -20,24 +24,34 @@ import (
 
 const (
 	port = ":50051"
+
+	// maxUpdateAttempts bounds the rereads of an UpdateTask without a version that
+	// keeps racing other writes
+	maxUpdateAttempts = 3
 )
 
 // server is used to implement the TaskList service
 type server struct {
 	pb.UnimplementedTaskListServer
-	taskRepo *database.TaskRepository
+	taskRepo   database.TaskStore
+	workflow   *models.Workflow
+	pageTokens *pagination.TokenCodec
+	shutdown   context.Context // done when the server starts shutting down
+
+	allowPrivateWebhooks bool // accept webhook URLs on loopback, link-local and private addresses
 }
 
 // CreateTask creates a new task and adds it to the database
 func (s *server) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
 	log.Printf("Received CreateTask request: %v", req)
 
-	// Convert protobuf request to internal model
-	createReq := models.FromProtoCreateTaskRequest(req)
-
-	// Validate the request
-	if err := createReq.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	// Convert and validate the request, reporting every violation at once
+	var v models.ValidationError
+	createReq := models.FromProtoCreateTaskRequest(req, &v)
+	v.Merge(createReq.Validate())
+	v.Merge(s.workflow.CheckStatus(models.FieldStatus, createReq.Status))
+	if err := v.Err(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
 	}
 
 	// Create internal task model
@@ -49,12 +63,19 @@ func (s *server) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb
 		Completed:   false,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Version:     1,
+		DueAt:       createReq.DueAt,
+		RemindAt:    createReq.RemindAt,
+		Priority:    createReq.Priority,
+		ProjectID:   createReq.ProjectID,
+		ParentID:    createReq.ParentID,
+		Recurrence:  createReq.Recurrence,
+		Status:      createReq.Status,
 	}
 
 	// Store the task
 	if err := s.taskRepo.CreateTask(ctx, task); err != nil {
-		log.Printf("Failed to create task: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to create task: %v", err)
+		return nil, toStatus(ctx, err, "failed to create task")
 	}
 
 	log.Printf("Created task with ID: %s", task.ID)
@@ -67,23 +88,56 @@ func (s *server) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTa
 
 	task, err := s.taskRepo.GetTask(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "task with ID %s not found", req.Id)
+		return nil, toStatus(ctx, err, "failed to get task %s", req.Id)
 	}
 
 	return task.ToProtoGetTaskResponse(), nil
 }
 
+// listScope binds ListTasks page tokens to the filters and order they were issued for
+func listScope(listReq *models.ListTasksRequest) string {
+	return "list:" + listReq.QueryKey()
+}
+
 // ListTasks returns a list of all tasks
 func (s *server) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
 	log.Printf("Received ListTasks request: %v", req)
 
 	// Convert protobuf request to internal model
-	listReq := models.FromProtoListTasksRequest(req)
+	listReq, err := models.FromProtoListTasksRequest(req)
+	if err != nil {
+		return nil, toStatus(ctx, err, "invalid request")
+	}
+
+	// Resolve the page token into a keyset cursor
+	if listReq.PageToken != "" {
+		var cursor models.PageCursor
+		err := s.pageTokens.Decode(listReq.PageToken, listScope(listReq), &cursor)
+		if err == nil && cursor.ID == "" {
+			err = pagination.ErrInvalidToken
+		}
+		if err != nil {
+			return nil, toStatus(ctx, err, "invalid page_token")
+		}
+		listReq.Cursor = &cursor
+
+		// Later pages evaluate overdue and due_within at the time of the first page
+		if !cursor.AsOf.IsZero() {
+			listReq.Filter.Now = cursor.AsOf
+		}
+	}
 
 	tasks, err := s.taskRepo.ListTasks(ctx, listReq)
 	if err != nil {
-		log.Printf("Failed to list tasks: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+		return nil, toStatus(ctx, err, "failed to list tasks")
+	}
+
+	if tasks.NextCursor != nil {
+		tasks.NextCursor.AsOf = listReq.Filter.Now
+		tasks.NextPageToken, err = s.pageTokens.Encode(*tasks.NextCursor, listScope(listReq))
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to encode page token")
+		}
 	}
 
 	return tasks.ToProtoListTasksResponse(), nil
@@ -93,66 +147,137 @@ func (s *server) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.L
 func (s *server) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	log.Printf("Received UpdateTask request: %v", req)
 
-	// Convert protobuf request to internal model
-	updateReq := models.FromProtoUpdateTaskRequest(req)
-
-	// Validate the request
-	if err := updateReq.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	// Convert and validate the request, reporting every violation at once
+	var v models.ValidationError
+	updateReq := models.FromProtoUpdateTaskRequest(req, &v)
+	v.Merge(updateReq.Validate())
+	if err := v.Err(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
 	}
 
-	// Check if task exists and get current task
-	existingTask, err := s.taskRepo.GetTask(ctx, req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "task with ID %s not found", req.Id)
+	for attempt := 1; ; attempt++ {
+		// Check if task exists and get current task
+		existingTask, err := s.taskRepo.GetTask(ctx, req.Id)
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to get task %s", req.Id)
+		}
+
+		// Reject edits based on a stale copy of the task
+		if updateReq.Version != 0 && updateReq.Version != existingTask.Version {
+			err := fmt.Errorf("task is at version %d, request expected %d: %w",
+				existingTask.Version, updateReq.Version, database.ErrConflict)
+			return nil, toStatus(ctx, err, "failed to update task %s", req.Id)
+		}
+
+		// Update only the fields named in the mask
+		updateReq.ApplyTo(existingTask)
+		existingTask.UpdatedAt = time.Now()
+
+		// Store updated task. The store guards the write with the version just read, so
+		// a request without a version of its own rereads the task when another write
+		// lands in between rather than failing a precondition it never set.
+		err = s.taskRepo.UpdateTask(ctx, existingTask, updateReq.Fields(), updateReq.IgnoreBlockers)
+		if errors.Is(err, database.ErrConflict) && updateReq.Version == 0 && attempt < maxUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to update task %s", req.Id)
+		}
+
+		return existingTask.ToProtoUpdateTaskResponse(), nil
 	}
-
-	// Update task fields
-	existingTask.Title = updateReq.Title
-	existingTask.Description = updateReq.Description
-	existingTask.Completed = updateReq.Completed
-	existingTask.UpdatedAt = time.Now()
-
-	// Store updated task
-	if err := s.taskRepo.UpdateTask(ctx, existingTask); err != nil {
-		log.Printf("Failed to update task: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to update task: %v", err)
-	}
-
-	return existingTask.ToProtoUpdateTaskResponse(), nil
 }
 
-// DeleteTask removes a task by ID
+// DeleteTask moves a task to the trash
 func (s *server) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
 	log.Printf("Received DeleteTask request: %v", req)
 
 	// Delete task
-	if err := s.taskRepo.DeleteTask(ctx, req.Id); err != nil {
-		log.Printf("Failed to delete task: %v", err)
-		return nil, status.Errorf(codes.NotFound, "task with ID %s not found", req.Id)
+	if err := s.taskRepo.DeleteTask(ctx, req.Id, req.Version); err != nil {
+		return nil, toStatus(ctx, err, "failed to delete task %s", req.Id)
 	}
 
 	return models.ToProtoDeleteTaskResponse(true), nil
 }
 
+// searchScope binds SearchTasks page tokens to the query they were issued for
+func searchScope(query string) string {
+	return "search:" + query
+}
+
+// SearchTasks runs a full-text search over task titles and descriptions
+func (s *server) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
+	log.Printf("Received SearchTasks request: %v", req)
+
+	// Convert protobuf request to internal model
+	searchReq := models.FromProtoSearchTasksRequest(req)
+
+	// Validate the request
+	if err := searchReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	// Page tokens are bound to the query they were issued for
+	if searchReq.PageToken != "" {
+		var cursor models.SearchCursor
+		err := s.pageTokens.Decode(searchReq.PageToken, searchScope(searchReq.Query), &cursor)
+		if err == nil && cursor.ID == "" {
+			err = pagination.ErrInvalidToken
+		}
+		if err != nil {
+			return nil, toStatus(ctx, err, "invalid page_token")
+		}
+		searchReq.Cursor = &cursor
+	}
+
+	results, err := s.taskRepo.SearchTasks(ctx, searchReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to search tasks")
+	}
+
+	if results.NextCursor != nil {
+		results.NextPageToken, err = s.pageTokens.Encode(*results.NextCursor, searchScope(searchReq.Query))
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to encode page token")
+		}
+	}
+
+	return results.ToProtoSearchTasksResponse(), nil
+}
+
 func main() {
 	// Load configuration
 	cfg := config.LoadConfig()
 
-	// Initialize PostgreSQL connection
-	db, err := database.NewPostgresDB(cfg.DB)
+	// "server migrate ..." manages the schema instead of serving
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg.DB, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// Statuses and their transitions are configurable, so check them before anything uses them
+	workflow, err := models.NewWorkflow(cfg.Workflow.Statuses, cfg.Workflow.DoneStatuses, cfg.Workflow.Transitions)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatalf("Invalid task workflow: %v", err)
 	}
-	defer db.Close()
 
-	// Create tasks table if it doesn't exist
-	if err := db.CreateTasksTable(); err != nil {
-		log.Fatalf("Failed to create tasks table: %v", err)
+	// Initialize the configured task store
+	taskRepo, closeStore, err := newTaskStore(cfg.DB, workflow)
+	if err != nil {
+		log.Fatalf("Failed to initialize task store: %v", err)
 	}
+	defer closeStore()
 
-	// Create task repository
-	taskRepo := database.NewTaskRepository(db)
+	// Page tokens are signed so clients cannot forge cursors
+	if cfg.Pagination.TokenSecret == "" {
+		log.Printf("PAGE_TOKEN_SECRET not set; page tokens will not survive a restart")
+	}
+	pageTokens, err := pagination.NewTokenCodec([]byte(cfg.Pagination.TokenSecret), cfg.Pagination.TokenTTL)
+	if err != nil {
+		log.Fatalf("Failed to initialize page tokens: %v", err)
+	}
 
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", getNetworkAddress(cfg.SConfig.ServerName, cfg.SConfig.Port))
@@ -160,11 +285,49 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryRequestID),
+		grpc.ChainStreamInterceptor(streamRequestID),
+	)
+
+	// Background workers and open watch streams stop when the server shuts down
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	taskServer := &server{
-		taskRepo: taskRepo,
+		taskRepo:   taskRepo,
+		workflow:   workflow,
+		pageTokens: pageTokens,
+		shutdown:   ctx,
+
+		allowPrivateWebhooks: cfg.Webhooks.AllowPrivateTargets,
 	}
 	pb.RegisterTaskListServer(s, taskServer)
+	go func() {
+		<-ctx.Done()
+		log.Printf("Shutting down server")
+		s.GracefulStop()
+	}()
+
+	if cfg.Trash.Retention > 0 {
+		go runTrashPurger(ctx, taskRepo, cfg.Trash.Retention, cfg.Trash.PurgeInterval)
+	}
+	if cfg.Watch.HistoryRetention > 0 {
+		go runChangePruner(ctx, taskRepo, cfg.Watch.HistoryRetention, cfg.Watch.PruneInterval)
+	}
+	if len(cfg.Outbox.Sinks) > 0 {
+		go runOutboxRelay(ctx, taskRepo, cfg.Outbox)
+	}
+	go runWebhookWorker(ctx, taskRepo, cfg.Webhooks)
+	if cfg.Webhooks.LogRetention > 0 {
+		go runWebhookLogPruner(ctx, taskRepo, cfg.Webhooks.LogRetention, time.Hour)
+	}
+	if cfg.Positions.RebalanceInterval > 0 {
+		go runPositionRebalancer(ctx, taskRepo, cfg.Positions.RebalanceInterval)
+	}
+	if cfg.Reminders.PollInterval > 0 {
+		go runReminderScheduler(ctx, taskRepo, cfg.Reminders.PollInterval)
+	}
 
 	if cfg.AppConfig.Environment == "development" {
 		log.Printf("Running in development mode")
@@ -177,6 +340,54 @@ func main() {
 	}
 }
 
+// newTaskStore opens the task store selected by cfg.Driver, moving tasks through
+// workflow, and returns a cleanup func
+func newTaskStore(cfg database.Config, workflow *models.Workflow) (database.TaskStore, func(), error) {
+	switch cfg.Driver {
+	case database.DriverMemory:
+		log.Printf("Using in-memory task store")
+		return database.NewMemoryTaskStore(workflow), func() {}, nil
+	case database.DriverPostgres, "":
+		// Initialize PostgreSQL connection
+		db, err := database.NewPostgresDB(cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+
+		// Bring the schema up to date
+		if cfg.AutoMigrate {
+			if err := migrateUp(db); err != nil {
+				db.Close()
+				return nil, nil, err
+			}
+		}
+
+		repo := database.NewTaskRepository(db, workflow)
+
+		// Tasks may hold statuses from an earlier workflow or from the status migration
+		settled, err := repo.SettleStatuses(context.Background())
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		if settled > 0 {
+			log.Printf("Moved %d tasks out of statuses the workflow does not have", settled)
+		}
+
+		// Relay trigger notifications to WatchTasks callers for as long as the store is open
+		listenCtx, stopListening := context.WithCancel(context.Background())
+		go func() {
+			if err := repo.ListenForChanges(listenCtx); err != nil {
+				log.Printf("Task change listener stopped: %v", err)
+			}
+		}()
+
+		return repo, func() { stopListening(); db.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported database driver: %q", cfg.Driver)
+	}
+}
+
 func getNetworkAddress(serverName string, port string) string {
 	return net.JoinHostPort(serverName, port)
 }
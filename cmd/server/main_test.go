@@ -0,0 +1,467 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// newTestServer returns a server over an empty in-memory store with the default workflow
+func newTestServer(t *testing.T) *server {
+	t.Helper()
+	workflow, err := models.NewWorkflow(
+		[]string{"todo", "in_progress", "in_review", "done", "wont_do"},
+		[]string{"done", "wont_do"},
+		[]string{"todo>in_progress", "in_progress>todo", "in_progress>in_review", "in_review>in_progress",
+			"*>done", "*>wont_do", "done>todo", "wont_do>todo"})
+	if err != nil {
+		t.Fatalf("NewWorkflow: %v", err)
+	}
+	pageTokens, err := pagination.NewTokenCodec([]byte("test secret"), time.Hour)
+	if err != nil {
+		t.Fatalf("NewTokenCodec: %v", err)
+	}
+	return &server{
+		taskRepo:   database.NewMemoryTaskStore(workflow),
+		workflow:   workflow,
+		pageTokens: pageTokens,
+		shutdown:   context.Background(),
+	}
+}
+
+// createTestTask creates a task with title through the handler
+func createTestTask(t *testing.T, s *server, title string) *pb.Task {
+	t.Helper()
+	resp, err := s.CreateTask(context.Background(), &pb.CreateTaskRequest{Title: title})
+	if err != nil {
+		t.Fatalf("CreateTask(%q): %v", title, err)
+	}
+	return resp.Task
+}
+
+// wantCode fails the test unless err is a status with code
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got code %s (%v), want %s", got, err, code)
+	}
+}
+
+func TestCreateAndGetTask(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	created, err := s.CreateTask(ctx, &pb.CreateTaskRequest{Title: "Write tests", Description: "for the handlers"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	task := created.Task
+	if task.Id == "" || task.Title != "Write tests" || task.Description != "for the handlers" {
+		t.Errorf("CreateTask returned %v", task)
+	}
+	if task.Version != 1 || task.Completed || task.Status != "todo" {
+		t.Errorf("new task has version %d, completed %t, status %q; want 1, false, todo", task.Version, task.Completed, task.Status)
+	}
+
+	got, err := s.GetTask(ctx, &pb.GetTaskRequest{Id: task.Id})
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	if got.Task.Id != task.Id || got.Task.Title != task.Title {
+		t.Errorf("GetTask returned %v, want %v", got.Task, task)
+	}
+}
+
+func TestCreateTaskValidation(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	for name, req := range map[string]*pb.CreateTaskRequest{
+		"empty title":    {},
+		"bad due_at":     {Title: "x", DueAt: "tomorrow"},
+		"unknown status": {Title: "x", Status: "blocked"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.CreateTask(ctx, req)
+			wantCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	_, err := s.CreateTask(ctx, &pb.CreateTaskRequest{Title: "x", ProjectId: "missing"})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestValidationCollectsEveryViolation(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := s.CreateTask(ctx, &pb.CreateTaskRequest{DueAt: "tomorrow", Status: "blocked"})
+	wantViolations(t, err, "due_at", "title", "status")
+
+	_, err = s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		RemindAt:   "soon",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "colour"}},
+	})
+	wantViolations(t, err, "remind_at", "id", "title", "update_mask")
+
+	_, err = s.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{Requests: []*pb.CreateTaskRequest{
+		{Title: "fine"},
+		{DueAt: "tomorrow", Status: "blocked"},
+	}})
+	wantViolations(t, err, "requests[1].due_at", "requests[1].title", "requests[1].status")
+}
+
+// wantViolations fails the test unless err is InvalidArgument with BadRequest
+// violations of exactly the given fields, in order
+func wantViolations(t *testing.T, err error, fields ...string) {
+	t.Helper()
+	wantCode(t, err, codes.InvalidArgument)
+	var got []string
+	for _, detail := range status.Convert(err).Details() {
+		if bad, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range bad.FieldViolations {
+				got = append(got, v.Field)
+			}
+		}
+	}
+	if !slices.Equal(got, fields) {
+		t.Errorf("violations of %v, want %v", got, fields)
+	}
+}
+
+func TestGetTaskNotFound(t *testing.T) {
+	s := newTestServer(t)
+	_, err := s.GetTask(context.Background(), &pb.GetTaskRequest{Id: "missing"})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestListTasks(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	want := make(map[string]bool)
+	for _, title := range []string{"one", "two", "three"} {
+		want[createTestTask(t, s, title).Id] = true
+	}
+
+	// Page through two at a time
+	seen := make(map[string]bool)
+	req := &pb.ListTasksRequest{PageSize: 2}
+	for pages := 0; ; pages++ {
+		if pages == 3 {
+			t.Fatal("ListTasks kept returning page tokens")
+		}
+		resp, err := s.ListTasks(ctx, req)
+		if err != nil {
+			t.Fatalf("ListTasks: %v", err)
+		}
+		if len(resp.Tasks) > 2 {
+			t.Errorf("page has %d tasks, want at most 2", len(resp.Tasks))
+		}
+		for _, task := range resp.Tasks {
+			if seen[task.Id] {
+				t.Errorf("task %s listed twice", task.Id)
+			}
+			seen[task.Id] = true
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if len(seen) != len(want) {
+		t.Errorf("listed %d tasks, want %d", len(seen), len(want))
+	}
+	for id := range want {
+		if !seen[id] {
+			t.Errorf("task %s was not listed", id)
+		}
+	}
+
+	// A filter narrows the list
+	resp, err := s.ListTasks(ctx, &pb.ListTasksRequest{Filter: `title = "two"`})
+	if err != nil {
+		t.Fatalf("ListTasks with filter: %v", err)
+	}
+	if len(resp.Tasks) != 1 || resp.Tasks[0].Title != "two" {
+		t.Errorf("filtered list = %v, want the task titled two", resp.Tasks)
+	}
+
+	_, err = s.ListTasks(ctx, &pb.ListTasksRequest{Filter: `nope = 1`})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.ListTasks(ctx, &pb.ListTasksRequest{PageToken: "garbage"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestListTasksSameCreatedAt(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	// Tasks created in the same instant are ordered by ID
+	created := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	var ids []string
+	for i := 0; i < 10; i++ {
+		task := &models.Task{ID: uuid.New().String(), Title: fmt.Sprintf("task %d", i),
+			CreatedAt: created, UpdatedAt: created}
+		if err := s.taskRepo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		ids = append(ids, task.ID)
+	}
+	slices.Sort(ids)
+
+	for _, order := range []string{"created_at", "created_at desc"} {
+		want := slices.Clone(ids)
+		if order == "created_at desc" {
+			slices.Reverse(want)
+		}
+
+		// Deleting a listed task while paging must not shift the later pages
+		var listed []string
+		req := &pb.ListTasksRequest{PageSize: 3, OrderBy: order}
+		for pages := 0; ; pages++ {
+			if pages == 5 {
+				t.Fatalf("order %q: ListTasks kept returning page tokens", order)
+			}
+			resp, err := s.ListTasks(ctx, req)
+			if err != nil {
+				t.Fatalf("order %q: ListTasks: %v", order, err)
+			}
+			for _, task := range resp.Tasks {
+				listed = append(listed, task.Id)
+			}
+			if pages == 0 {
+				if err := s.taskRepo.DeleteTask(ctx, resp.Tasks[0].Id, 0); err != nil {
+					t.Fatalf("DeleteTask: %v", err)
+				}
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		if !slices.Equal(listed, want) {
+			t.Errorf("order %q: listed %v, want %v", order, listed, want)
+		}
+		if _, err := s.taskRepo.RestoreTask(ctx, want[0], 0); err != nil {
+			t.Fatalf("RestoreTask: %v", err)
+		}
+	}
+}
+
+func TestListTasksPageTokenScope(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	for _, title := range []string{"one", "two", "three"} {
+		createTestTask(t, s, title)
+	}
+
+	resp, err := s.ListTasks(ctx, &pb.ListTasksRequest{PageSize: 1, Filter: `completed = false`})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if resp.NextPageToken == "" {
+		t.Fatal("ListTasks returned no page token")
+	}
+
+	// The token only continues the query that issued it
+	for _, req := range []*pb.ListTasksRequest{
+		{PageSize: 1, PageToken: resp.NextPageToken},
+		{PageSize: 1, PageToken: resp.NextPageToken, Filter: `completed = true`},
+		{PageSize: 1, PageToken: resp.NextPageToken, Filter: `completed = false`, OrderBy: "title"},
+	} {
+		_, err := s.ListTasks(ctx, req)
+		wantCode(t, err, codes.InvalidArgument)
+	}
+	// The first signature character carries six full bits, unlike the last
+	body, sig, _ := strings.Cut(resp.NextPageToken, ".")
+	first := "A"
+	if sig[:1] == first {
+		first = "B"
+	}
+	tampered := body + "." + first + sig[1:]
+	_, err = s.ListTasks(ctx, &pb.ListTasksRequest{PageSize: 1, PageToken: tampered, Filter: `completed = false`})
+	wantCode(t, err, codes.InvalidArgument)
+
+	if _, err := s.ListTasks(ctx, &pb.ListTasksRequest{PageSize: 1, PageToken: resp.NextPageToken, Filter: `completed = false`}); err != nil {
+		t.Errorf("ListTasks with the issuing query: %v", err)
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	task := createTestTask(t, s, "before")
+
+	resp, err := s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		Id:         task.Id,
+		Title:      "after",
+		Completed:  true,
+		Version:    task.Version,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "completed"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	updated := resp.Task
+	if updated.Title != "after" || !updated.Completed || updated.Status != "done" {
+		t.Errorf("updated task has title %q, completed %t, status %q; want after, true, done",
+			updated.Title, updated.Completed, updated.Status)
+	}
+	if updated.Version != task.Version+1 {
+		t.Errorf("updated task is at version %d, want %d", updated.Version, task.Version+1)
+	}
+
+	// Fields outside the mask stay as they were
+	resp, err = s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		Id:          task.Id,
+		Title:       "ignored",
+		Description: "set",
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if resp.Task.Title != "after" || resp.Task.Description != "set" {
+		t.Errorf("masked update gave title %q, description %q; want after, set", resp.Task.Title, resp.Task.Description)
+	}
+
+	got, err := s.GetTask(ctx, &pb.GetTaskRequest{Id: task.Id})
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	if got.Task.Version != resp.Task.Version || got.Task.Description != "set" {
+		t.Errorf("stored task %v does not match the update response %v", got.Task, resp.Task)
+	}
+}
+
+// racingStore runs race after each of the next reads of a task, standing in for
+// writes that land between UpdateTask reading the task and writing it back
+type racingStore struct {
+	database.TaskStore
+	races int
+	race  func()
+}
+
+func (r *racingStore) GetTask(ctx context.Context, id string) (*models.Task, error) {
+	task, err := r.TaskStore.GetTask(ctx, id)
+	if r.races > 0 {
+		r.races--
+		r.race()
+	}
+	return task, err
+}
+
+func TestUpdateTaskRacingWrites(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	task := createTestTask(t, s, "before")
+
+	store := &racingStore{TaskStore: s.taskRepo, races: 1}
+	store.race = func() {
+		current, err := store.TaskStore.GetTask(ctx, task.Id)
+		if err != nil {
+			t.Fatalf("GetTask: %v", err)
+		}
+		current.Description = "raced"
+		if err := store.TaskStore.UpdateTask(ctx, current, []string{models.FieldDescription}, false); err != nil {
+			t.Fatalf("racing UpdateTask: %v", err)
+		}
+	}
+	s.taskRepo = store
+
+	// Without a version the update rereads the task and applies on top of the race
+	resp, err := s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		Id:         task.Id,
+		Title:      "after",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask without a version: %v", err)
+	}
+	if resp.Task.Title != "after" || resp.Task.Description != "raced" || resp.Task.Version != task.Version+2 {
+		t.Errorf("updated task = %q, %q at version %d; want both writes at version %d",
+			resp.Task.Title, resp.Task.Description, resp.Task.Version, task.Version+2)
+	}
+
+	// With a version the race is a conflict
+	store.races = 1
+	_, err = s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		Id:         task.Id,
+		Title:      "again",
+		Version:    resp.Task.Version,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	wantCode(t, err, codes.Aborted)
+
+	// A task that keeps racing gives up after maxUpdateAttempts reads
+	store.races = maxUpdateAttempts
+	_, err = s.UpdateTask(ctx, &pb.UpdateTaskRequest{
+		Id:         task.Id,
+		Title:      "never",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	wantCode(t, err, codes.Aborted)
+	if store.races != 0 {
+		t.Errorf("UpdateTask read the task %d times, want %d", maxUpdateAttempts-store.races, maxUpdateAttempts)
+	}
+}
+
+func TestUpdateTaskErrors(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	task := createTestTask(t, s, "task")
+
+	_, err := s.UpdateTask(ctx, &pb.UpdateTaskRequest{Id: "missing", Title: "x"})
+	wantCode(t, err, codes.NotFound)
+
+	_, err = s.UpdateTask(ctx, &pb.UpdateTaskRequest{Id: task.Id, Title: "x", Version: task.Version + 1})
+	wantCode(t, err, codes.Aborted)
+
+	_, err = s.UpdateTask(ctx, &pb.UpdateTaskRequest{Id: task.Id, Title: "x",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"no_such_field"}}})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestDeleteTask(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	task := createTestTask(t, s, "doomed")
+
+	_, err := s.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: task.Id, Version: task.Version + 1})
+	wantCode(t, err, codes.Aborted)
+
+	resp, err := s.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: task.Id, Version: task.Version})
+	if err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if !resp.Success {
+		t.Error("DeleteTask did not report success")
+	}
+
+	_, err = s.GetTask(ctx, &pb.GetTaskRequest{Id: task.Id})
+	wantCode(t, err, codes.NotFound)
+	_, err = s.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: task.Id})
+	wantCode(t, err, codes.NotFound)
+
+	list, err := s.ListTasks(ctx, &pb.ListTasksRequest{})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 0 {
+		t.Errorf("ListTasks returned %d tasks after the only one was deleted", len(list.Tasks))
+	}
+}
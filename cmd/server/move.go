@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// MoveTask places a task in the manual order
+func (s *server) MoveTask(ctx context.Context, req *pb.MoveTaskRequest) (*pb.MoveTaskResponse, error) {
+	log.Printf("Received MoveTask request: %v", req)
+
+	// Convert protobuf request to internal model
+	moveReq := models.FromProtoMoveTaskRequest(req)
+
+	if err := moveReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task, err := s.taskRepo.MoveTask(ctx, moveReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to move task %s", req.Id)
+	}
+
+	return task.ToProtoMoveTaskResponse(), nil
+}
+
+// runPositionRebalancer spreads out the manual order whenever its keys have grown
+// longer than rank.MaxLength, checking every interval until ctx is cancelled
+func runPositionRebalancer(ctx context.Context, store database.TaskStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	ctx = audit.WithActor(ctx, audit.System)
+	defer ticker.Stop()
+
+	for {
+		spread, err := store.RebalancePositions(ctx, rank.MaxLength)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to rebalance task positions: %v", err)
+		} else if spread > 0 {
+			log.Printf("Rebalanced the positions of %d tasks", spread)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/config"
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/outbox"
+)
+
+// newOutboxSink builds the sinks named in cfg.Sinks and returns a cleanup func
+func newOutboxSink(cfg config.OutboxConfig) (outbox.Sink, func(), error) {
+	var sinks outbox.MultiSink
+	cleanup := func() {}
+
+	for _, name := range cfg.Sinks {
+		switch name {
+		case "stdout":
+			sinks = append(sinks, outbox.NewWriterSink(os.Stdout))
+		case "file":
+			sink, f, err := outbox.OpenFileSink(cfg.FilePath)
+			if err != nil {
+				cleanup()
+				return nil, nil, err
+			}
+			sinks = append(sinks, sink)
+			prev := cleanup
+			cleanup = func() { f.Close(); prev() }
+		case "webhook":
+			if cfg.WebhookURL == "" {
+				cleanup()
+				return nil, nil, fmt.Errorf("OUTBOX_WEBHOOK_URL is required for the webhook sink")
+			}
+			sinks = append(sinks, outbox.NewWebhookSink(cfg.WebhookURL, cfg.WebhookTimeout))
+		default:
+			cleanup()
+			return nil, nil, fmt.Errorf("unsupported outbox sink: %q", name)
+		}
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], cleanup, nil
+	}
+	return sinks, cleanup, nil
+}
+
+// runOutboxRelay publishes outbox messages to the configured sinks until ctx is done
+func runOutboxRelay(ctx context.Context, store database.TaskStore, cfg config.OutboxConfig) {
+	source, ok := store.(outbox.Source)
+	if !ok {
+		log.Printf("Task store has no outbox; OUTBOX_SINKS is ignored")
+		return
+	}
+
+	sink, closeSink, err := newOutboxSink(cfg)
+	if err != nil {
+		log.Printf("Outbox relay disabled: %v", err)
+		return
+	}
+	defer closeSink()
+
+	policy := outbox.DefaultRetryPolicy
+	if cfg.MaxAttempts > 0 {
+		policy.MaxAttempts = cfg.MaxAttempts
+	}
+
+	log.Printf("Relaying outbox messages to %v", cfg.Sinks)
+	outbox.NewRelay(source, sink, policy, cfg.BatchSize, cfg.PollInterval).Run(ctx)
+}
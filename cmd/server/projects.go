@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"github.com/google/uuid"
+)
+
+// CreateProject adds a project tasks can be created in or moved to
+func (s *server) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+	log.Printf("Received CreateProject request: %v", req)
+
+	// Convert protobuf request to internal model
+	createReq := models.FromProtoCreateProjectRequest(req)
+
+	// Validate the request
+	if err := createReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	now := time.Now()
+	project := &models.Project{
+		ID:          uuid.New().String(),
+		Name:        createReq.Name,
+		Description: createReq.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.taskRepo.CreateProject(ctx, project); err != nil {
+		return nil, toStatus(ctx, err, "failed to create project")
+	}
+
+	log.Printf("Created project with ID: %s", project.ID)
+	return &pb.CreateProjectResponse{Project: project.ToProtoProject()}, nil
+}
+
+// GetProject retrieves a project by ID
+func (s *server) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	log.Printf("Received GetProject request: %v", req)
+
+	if req.Id == "" {
+		verr := &models.ValidationError{}
+		verr.Add("id", models.ReasonRequired, "id cannot be empty")
+		return nil, toStatus(ctx, verr, "validation failed")
+	}
+
+	project, err := s.taskRepo.GetProject(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to get project %s", req.Id)
+	}
+	return &pb.GetProjectResponse{Project: project.ToProtoProject()}, nil
+}
+
+// UpdateProject renames or redescribes a project
+func (s *server) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
+	log.Printf("Received UpdateProject request: %v", req)
+
+	// Convert protobuf request to internal model
+	updateReq := models.FromProtoUpdateProjectRequest(req)
+
+	// Validate the request
+	if err := updateReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	project, err := s.taskRepo.UpdateProject(ctx, updateReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to update project %s", updateReq.ID)
+	}
+
+	log.Printf("Updated project with ID: %s", project.ID)
+	return &pb.UpdateProjectResponse{Project: project.ToProtoProject()}, nil
+}
+
+// DeleteProject deletes a project, moving or trashing its tasks as requested
+func (s *server) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	log.Printf("Received DeleteProject request: %v", req)
+
+	// Convert protobuf request to internal model
+	deleteReq := models.FromProtoDeleteProjectRequest(req)
+
+	// Validate the request
+	if err := deleteReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	if err := s.taskRepo.DeleteProject(ctx, deleteReq); err != nil {
+		return nil, toStatus(ctx, err, "failed to delete project %s", deleteReq.ID)
+	}
+
+	log.Printf("Deleted project with ID: %s", deleteReq.ID)
+	return &pb.DeleteProjectResponse{Success: true}, nil
+}
+
+// ListProjects lists every project by name
+func (s *server) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	log.Printf("Received ListProjects request: %v", req)
+
+	projects, err := s.taskRepo.ListProjects(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list projects")
+	}
+
+	resp := &pb.ListProjectsResponse{Projects: make([]*pb.Project, len(projects))}
+	for i, project := range projects {
+		resp.Projects[i] = project.ToProtoProject()
+	}
+	return resp, nil
+}
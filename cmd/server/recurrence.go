@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// PreviewOccurrences lists the due dates of the next occurrences of a recurring task
+func (s *server) PreviewOccurrences(ctx context.Context, req *pb.PreviewOccurrencesRequest) (*pb.PreviewOccurrencesResponse, error) {
+	log.Printf("Received PreviewOccurrences request: %v", req)
+
+	// Convert protobuf request to internal model
+	previewReq, err := models.FromProtoPreviewOccurrencesRequest(req)
+	if err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	// Validate the request
+	if err := previewReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task := &models.Task{Recurrence: previewReq.Recurrence, DueAt: previewReq.DueAt}
+	if previewReq.TaskID != "" {
+		if task, err = s.taskRepo.GetTask(ctx, previewReq.TaskID); err != nil {
+			return nil, toStatus(ctx, err, "failed to get task %s", previewReq.TaskID)
+		}
+	}
+
+	dueAt, err := task.UpcomingOccurrences(time.Now(), previewReq.PreviewLimit())
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to preview occurrences")
+	}
+	return models.ToProtoPreviewOccurrencesResponse(dueAt), nil
+}
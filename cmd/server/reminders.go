@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+)
+
+// reminderBatchSize bounds the reminders fired per store call
+const reminderBatchSize = 100
+
+// runReminderScheduler fires due reminders every interval until ctx is cancelled.
+// Each fired reminder reaches watchers, the outbox and webhooks as a reminded change;
+// the store guarantees it fires once even when every replica runs a scheduler.
+func runReminderScheduler(ctx context.Context, store database.TaskStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	ctx = audit.WithActor(ctx, audit.System)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while batches come back full so a backlog drains in one tick
+		for {
+			fired, err := store.FireReminders(ctx, time.Now(), reminderBatchSize)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("Failed to fire reminders: %v", err)
+				}
+				break
+			}
+			if len(fired) > 0 {
+				log.Printf("Fired %d task reminders", len(fired))
+			}
+			if len(fired) < reminderBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
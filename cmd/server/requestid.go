@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDHeader carries the request ID in both directions
+const requestIDHeader = "x-request-id"
+
+// actorHeader names the caller recorded in task history. It is trusted as sent, so
+// deployments must have their gateway set it from the authenticated identity.
+const actorHeader = "x-actor"
+
+// maxRequestIDLength bounds client-supplied request IDs and actors
+const maxRequestIDLength = 128
+
+// requestIDFromContext returns the request ID assigned by the interceptors
+func requestIDFromContext(ctx context.Context) string {
+	return audit.RequestIDFrom(ctx)
+}
+
+// incomingValue returns the first value of a metadata key, or "" if it is missing or too long
+func incomingValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 && len(values[0]) <= maxRequestIDLength {
+		return values[0]
+	}
+	return ""
+}
+
+// withRequestID reuses the caller's x-request-id or generates one, echoes it back in
+// the response header, and records the caller's x-actor for task history
+func withRequestID(ctx context.Context) context.Context {
+	id := incomingValue(ctx, requestIDHeader)
+	if id == "" {
+		id = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+
+	ctx = audit.WithRequestID(ctx, id)
+	if actor := incomingValue(ctx, actorHeader); actor != "" {
+		ctx = audit.WithActor(ctx, actor)
+	}
+	return ctx
+}
+
+// unaryRequestID assigns a request ID to every unary call
+func unaryRequestID(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+// requestIDStream overrides the context of a server stream
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context { return s.ctx }
+
+// streamRequestID assigns a request ID to every streaming call
+func streamRequestID(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &requestIDStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+}
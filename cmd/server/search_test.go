@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSearchTasksPaging(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	// Ranks 3, 2, 1, 1, 1; ties are broken by descending ID
+	want := map[string]bool{}
+	for _, title := range []string{"milk", "milk milk milk", "buy milk", "milk milk", "oat milk"} {
+		want[createTestTask(t, s, title).Id] = true
+	}
+	createTestTask(t, s, "bread")
+
+	var got []*pb.SearchResult
+	req := &pb.SearchTasksRequest{Query: "milk", PageSize: 2}
+	for pages := 0; ; pages++ {
+		if pages > len(want) {
+			t.Fatal("paging does not terminate")
+		}
+		resp, err := s.SearchTasks(ctx, req)
+		if err != nil {
+			t.Fatalf("SearchTasks: %v", err)
+		}
+		if len(resp.Results) > 2 {
+			t.Fatalf("page has %d results, want at most 2", len(resp.Results))
+		}
+		got = append(got, resp.Results...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i, result := range got {
+		if !want[result.Task.Id] {
+			t.Errorf("result %q listed twice or does not match", result.Task.Title)
+		}
+		delete(want, result.Task.Id)
+		if i == 0 {
+			continue
+		}
+		prev := got[i-1]
+		if result.Rank > prev.Rank || result.Rank == prev.Rank && result.Task.Id > prev.Task.Id {
+			t.Errorf("result %d (%q, %v) is ranked above result %d (%q, %v)",
+				i, result.Task.Title, result.Rank, i-1, prev.Task.Title, prev.Rank)
+		}
+	}
+	if got[0].Task.Title != "milk milk milk" {
+		t.Errorf("best result is %q, want %q", got[0].Task.Title, "milk milk milk")
+	}
+	if got[0].TitleSnippet != "<b>milk</b> <b>milk</b> <b>milk</b>" {
+		t.Errorf("TitleSnippet = %q", got[0].TitleSnippet)
+	}
+}
+
+func TestSearchTasksPageTokenScope(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	for _, title := range []string{"milk", "oat milk", "milk and bread"} {
+		createTestTask(t, s, title)
+	}
+
+	resp, err := s.SearchTasks(ctx, &pb.SearchTasksRequest{Query: "milk", PageSize: 1})
+	if err != nil {
+		t.Fatalf("SearchTasks: %v", err)
+	}
+	if resp.NextPageToken == "" {
+		t.Fatal("no NextPageToken for a partial page")
+	}
+
+	_, err = s.SearchTasks(ctx, &pb.SearchTasksRequest{Query: "bread", PageSize: 1, PageToken: resp.NextPageToken})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.ListTasks(ctx, &pb.ListTasksRequest{PageSize: 1, PageToken: resp.NextPageToken})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.ListDeletedTasks(ctx, &pb.ListDeletedTasksRequest{PageSize: 1, PageToken: resp.NextPageToken})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.SearchTasks(ctx, &pb.SearchTasksRequest{Query: "milk", PageToken: "garbage"})
+	wantCode(t, err, codes.InvalidArgument)
+	_, err = s.SearchTasks(ctx, &pb.SearchTasksRequest{Query: "  "})
+	wantCode(t, err, codes.InvalidArgument)
+	if _, err := s.SearchTasks(ctx, &pb.SearchTasksRequest{Query: "milk", PageSize: 1, PageToken: resp.NextPageToken}); err != nil {
+		t.Errorf("SearchTasks with its own token: %v", err)
+	}
+}
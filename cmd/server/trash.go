@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// trashScope binds ListDeletedTasks page tokens to that RPC
+const trashScope = "trash:"
+
+// RestoreTask moves a task out of the trash
+func (s *server) RestoreTask(ctx context.Context, req *pb.RestoreTaskRequest) (*pb.RestoreTaskResponse, error) {
+	log.Printf("Received RestoreTask request: %v", req)
+
+	if req.Id == "" {
+		verr := &models.ValidationError{}
+		verr.Add("id", models.ReasonRequired, "id cannot be empty")
+		return nil, toStatus(ctx, verr, "validation failed")
+	}
+
+	task, err := s.taskRepo.RestoreTask(ctx, req.Id, req.Version)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to restore task %s", req.Id)
+	}
+
+	return task.ToProtoRestoreTaskResponse(), nil
+}
+
+// ListDeletedTasks lists tasks in the trash, most recently deleted first
+func (s *server) ListDeletedTasks(ctx context.Context, req *pb.ListDeletedTasksRequest) (*pb.ListDeletedTasksResponse, error) {
+	log.Printf("Received ListDeletedTasks request: %v", req)
+
+	// Convert protobuf request to internal model
+	listReq := models.FromProtoListDeletedTasksRequest(req)
+
+	if listReq.PageToken != "" {
+		var cursor models.TrashCursor
+		err := s.pageTokens.Decode(listReq.PageToken, trashScope, &cursor)
+		if err == nil && cursor.ID == "" {
+			err = pagination.ErrInvalidToken
+		}
+		if err != nil {
+			return nil, toStatus(ctx, err, "invalid page_token")
+		}
+		listReq.Cursor = &cursor
+	}
+
+	tasks, err := s.taskRepo.ListDeletedTasks(ctx, listReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list deleted tasks")
+	}
+
+	if tasks.NextCursor != nil {
+		tasks.NextPageToken, err = s.pageTokens.Encode(*tasks.NextCursor, trashScope)
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to encode page token")
+		}
+	}
+
+	return tasks.ToProtoListDeletedTasksResponse(), nil
+}
+
+// runTrashPurger permanently deletes tasks that have been in the trash longer than
+// retention, checking every interval until ctx is cancelled
+func runTrashPurger(ctx context.Context, store database.TaskStore, retention, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	ctx = audit.WithActor(ctx, audit.System)
+	defer ticker.Stop()
+
+	for {
+		purged, err := store.PurgeDeletedTasks(ctx, time.Now().Add(-retention))
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to purge deleted tasks: %v", err)
+		} else if purged > 0 {
+			log.Printf("Purged %d deleted tasks", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// GetTaskTree returns a task with its subtasks and their progress
+func (s *server) GetTaskTree(ctx context.Context, req *pb.GetTaskTreeRequest) (*pb.GetTaskTreeResponse, error) {
+	log.Printf("Received GetTaskTree request: %v", req)
+
+	// Convert protobuf request to internal model
+	treeReq := models.FromProtoGetTaskTreeRequest(req)
+
+	if err := treeReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	root, err := s.taskRepo.GetTaskTree(ctx, treeReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to get task tree %s", req.Id)
+	}
+
+	return &pb.GetTaskTreeResponse{Root: root.ToProtoTaskTreeNode()}, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchTasks streams task changes to the client until it disconnects
+func (s *server) WatchTasks(req *pb.WatchTasksRequest, stream pb.TaskList_WatchTasksServer) error {
+	log.Printf("Received WatchTasks request: %v", req)
+
+	// Streams never finish on their own, so end them when the server shuts down
+	// instead of letting GracefulStop wait forever
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	if s.shutdown != nil {
+		defer context.AfterFunc(s.shutdown, cancel)()
+	}
+
+	watchReq, err := models.FromProtoWatchTasksRequest(req)
+	if err != nil {
+		return toStatus(ctx, err, "invalid request")
+	}
+
+	err = s.taskRepo.WatchTasks(ctx, watchReq.After, func(ev models.TaskEvent) error {
+		return stream.Send(ev.ToProtoTaskEvent())
+	})
+	if s.shutdown != nil && s.shutdown.Err() != nil {
+		return status.Error(codes.Unavailable, "server is shutting down; resume from the last cursor")
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		// The client went away; a failed Send is only a symptom
+		err = ctxErr
+	}
+	return toStatus(ctx, err, "watch ended")
+}
+
+// runChangePruner drops change history older than retention, checking every interval
+// until ctx is cancelled
+func runChangePruner(ctx context.Context, store database.TaskStore, retention, interval time.Duration) {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := store.PruneTaskChanges(ctx, time.Now().Add(-retention))
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to prune task changes: %v", err)
+		} else if pruned > 0 {
+			log.Printf("Pruned %d task changes", pruned)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
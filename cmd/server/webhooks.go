@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/config"
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/pagination"
+	"github.com/Samarth11-A/TaskListAPI/internal/webhook"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"github.com/google/uuid"
+)
+
+// deliveriesScope binds ListWebhookDeliveries page tokens to the webhook they were issued for
+func deliveriesScope(webhookID string) string {
+	return "deliveries:" + webhookID
+}
+
+// CreateWebhook registers an endpoint for task events and returns its signing secret
+func (s *server) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	// The request carries the secret, so only log what identifies the webhook
+	log.Printf("Received CreateWebhook request: url=%q event_types=%v", req.Url, req.EventTypes)
+
+	// Convert protobuf request to internal model
+	createReq := models.FromProtoCreateWebhookRequest(req)
+
+	// Validate the request
+	if err := createReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+	if !s.allowPrivateWebhooks {
+		if err := webhook.CheckURL(createReq.URL); err != nil {
+			verr := &models.ValidationError{}
+			verr.Add("url", models.ReasonInvalidFormat, "url must not point at a loopback, link-local or private address")
+			return nil, toStatus(ctx, verr, "validation failed")
+		}
+	}
+
+	secret := createReq.Secret
+	if secret == "" {
+		var err error
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, toStatus(ctx, err, "failed to create webhook")
+		}
+	}
+
+	hook := &models.Webhook{
+		ID:         uuid.New().String(),
+		URL:        createReq.URL,
+		EventTypes: createReq.EventTypes,
+		Secret:     secret,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.taskRepo.CreateWebhook(ctx, hook); err != nil {
+		return nil, toStatus(ctx, err, "failed to create webhook")
+	}
+
+	log.Printf("Created webhook with ID: %s", hook.ID)
+	resp := hook.ToProtoWebhook()
+	resp.Secret = hook.Secret
+	return &pb.CreateWebhookResponse{Webhook: resp}, nil
+}
+
+// ListWebhooks lists every webhook, oldest first, without their secrets
+func (s *server) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	log.Printf("Received ListWebhooks request: %v", req)
+
+	hooks, err := s.taskRepo.ListWebhooks(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list webhooks")
+	}
+
+	resp := &pb.ListWebhooksResponse{Webhooks: make([]*pb.Webhook, len(hooks))}
+	for i, hook := range hooks {
+		resp.Webhooks[i] = hook.ToProtoWebhook()
+	}
+	return resp, nil
+}
+
+// DeleteWebhook removes a webhook along with its pending deliveries and delivery log
+func (s *server) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	log.Printf("Received DeleteWebhook request: %v", req)
+
+	if req.Id == "" {
+		verr := &models.ValidationError{}
+		verr.Add("id", models.ReasonRequired, "id cannot be empty")
+		return nil, toStatus(ctx, verr, "validation failed")
+	}
+
+	if err := s.taskRepo.DeleteWebhook(ctx, req.Id); err != nil {
+		return nil, toStatus(ctx, err, "failed to delete webhook %s", req.Id)
+	}
+
+	log.Printf("Deleted webhook with ID: %s", req.Id)
+	return &pb.DeleteWebhookResponse{Success: true}, nil
+}
+
+// ListWebhookDeliveries lists the delivery attempts made to a webhook, newest first
+func (s *server) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	log.Printf("Received ListWebhookDeliveries request: %v", req)
+
+	// Convert protobuf request to internal model
+	listReq := models.FromProtoListWebhookDeliveriesRequest(req)
+
+	// Validate the request
+	if err := listReq.Validate(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	if listReq.PageToken != "" {
+		var cursor models.DeliveryCursor
+		err := s.pageTokens.Decode(listReq.PageToken, deliveriesScope(listReq.WebhookID), &cursor)
+		if err == nil && cursor.ID == 0 {
+			err = pagination.ErrInvalidToken
+		}
+		if err != nil {
+			return nil, toStatus(ctx, err, "invalid page_token")
+		}
+		listReq.Cursor = &cursor
+	}
+
+	deliveries, err := s.taskRepo.ListWebhookDeliveries(ctx, listReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to list deliveries of webhook %s", listReq.WebhookID)
+	}
+
+	if deliveries.NextCursor != nil {
+		deliveries.NextPageToken, err = s.pageTokens.Encode(*deliveries.NextCursor, deliveriesScope(listReq.WebhookID))
+		if err != nil {
+			return nil, toStatus(ctx, err, "failed to encode page token")
+		}
+	}
+
+	return deliveries.ToProtoListWebhookDeliveriesResponse(), nil
+}
+
+// runWebhookWorker delivers queued webhook events until ctx is done
+func runWebhookWorker(ctx context.Context, store database.WebhookStore, cfg config.WebhookConfig) {
+	retry := webhook.DefaultRetryPolicy
+	if cfg.MaxAttempts > 0 {
+		retry.MaxAttempts = cfg.MaxAttempts
+	}
+
+	webhook.NewWorker(store, webhook.Config{
+		Timeout:      cfg.Timeout,
+		Retry:        retry,
+		Disable:      models.WebhookDisablePolicy{Failures: cfg.DisableFailures, Period: cfg.DisablePeriod},
+		Concurrency:  cfg.Concurrency,
+		PollInterval: cfg.PollInterval,
+
+		AllowPrivateTargets: cfg.AllowPrivateTargets,
+	}).Run(ctx)
+}
+
+// runWebhookLogPruner drops delivery log entries older than retention every interval
+func runWebhookLogPruner(ctx context.Context, store database.WebhookStore, retention, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := store.PruneWebhookDeliveries(ctx, time.Now().Add(-retention))
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to prune webhook deliveries: %v", err)
+		} else if pruned > 0 {
+			log.Printf("Pruned %d webhook deliveries", pruned)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateWebhookTargets(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook", "http://169.254.169.254/"} {
+		_, err := s.CreateWebhook(ctx, &pb.CreateWebhookRequest{Url: url})
+		wantCode(t, err, codes.InvalidArgument)
+	}
+
+	resp, err := s.CreateWebhook(ctx, &pb.CreateWebhookRequest{Url: "https://example.com/hook"})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if resp.Webhook.Secret == "" {
+		t.Error("CreateWebhook did not return the generated secret")
+	}
+
+	// Development setups may opt in to private targets
+	s.allowPrivateWebhooks = true
+	if _, err := s.CreateWebhook(ctx, &pb.CreateWebhookRequest{Url: "http://127.0.0.1:8080/hook"}); err != nil {
+		t.Errorf("CreateWebhook with private targets allowed: %v", err)
+	}
+}
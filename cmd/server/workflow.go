@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	pb "github.com/Samarth11-A/TaskList_proto/api"
+)
+
+// TransitionTask moves a task to another status of the workflow
+func (s *server) TransitionTask(ctx context.Context, req *pb.TransitionTaskRequest) (*pb.TransitionTaskResponse, error) {
+	log.Printf("Received TransitionTask request: %v", req)
+
+	// Convert protobuf request to internal model
+	transitionReq := models.FromProtoTransitionTaskRequest(req)
+
+	// Validate the request, reporting every violation at once
+	var v models.ValidationError
+	v.Merge(transitionReq.Validate())
+	v.Merge(s.workflow.CheckStatus(models.FieldStatus, transitionReq.Status))
+	if err := v.Err(); err != nil {
+		return nil, toStatus(ctx, err, "validation failed")
+	}
+
+	task, err := s.taskRepo.TransitionTask(ctx, transitionReq)
+	if err != nil {
+		return nil, toStatus(ctx, err, "failed to transition task %s", transitionReq.ID)
+	}
+
+	log.Printf("Moved task %s to status %s", task.ID, task.Status)
+	return &pb.TransitionTaskResponse{Task: task.ToProtoTask()}, nil
+}
+
+// GetWorkflow describes the task statuses and the transitions between them
+func (s *server) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (*pb.GetWorkflowResponse, error) {
+	log.Printf("Received GetWorkflow request: %v", req)
+
+	return s.workflow.ToProtoGetWorkflowResponse(), nil
+}
@@ -0,0 +1,39 @@
+// Package audit carries who made a change, and in which request, from the gRPC
+// layer down to the stores that record task history.
+package audit
+
+import "context"
+
+// Actors recorded when a change has no caller-supplied identity
+const (
+	Anonymous = "anonymous"
+	System    = "system"
+)
+
+type actorKey struct{}
+
+type requestIDKey struct{}
+
+// WithActor returns a context carrying the identity making changes
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor stored in ctx, or Anonymous
+func ActorFrom(ctx context.Context) string {
+	if actor, _ := ctx.Value(actorKey{}).(string); actor != "" {
+		return actor
+	}
+	return Anonymous
+}
+
+// WithRequestID returns a context carrying the ID of the request being served
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the request ID stored in ctx, or ""
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
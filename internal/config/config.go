@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Samarth11-A/TaskListAPI/internal/database"
 )
@@ -18,11 +19,70 @@ type ServerConfig struct {
 	ServerName string
 }
 
+type PaginationConfig struct {
+	TokenSecret string        // HMAC key for page tokens; random per process when empty
+	TokenTTL    time.Duration // how long an issued page token stays valid
+}
+
+type TrashConfig struct {
+	Retention     time.Duration // how long deleted tasks stay restorable; 0 keeps them forever
+	PurgeInterval time.Duration // how often expired tasks are purged
+}
+
+type WatchConfig struct {
+	HistoryRetention time.Duration // how long WatchTasks cursors stay resumable; 0 keeps history forever
+	PruneInterval    time.Duration // how often expired change history is pruned
+}
+
+type OutboxConfig struct {
+	Sinks          []string      // "stdout", "file" and/or "webhook"; empty disables the relay
+	FilePath       string        // JSON lines file for the "file" sink
+	WebhookURL     string        // endpoint for the "webhook" sink
+	WebhookTimeout time.Duration // per-request timeout of the "webhook" sink
+	PollInterval   time.Duration // how often the relay polls when the outbox is idle
+	BatchSize      int           // messages claimed per relay transaction
+	MaxAttempts    int           // deliveries tried before a message is dead-lettered
+}
+
+type WebhookConfig struct {
+	Timeout         time.Duration // per delivery request
+	MaxAttempts     int           // attempts before a delivery is given up on
+	DisableFailures int           // consecutive failed attempts before a webhook is disabled; 0 never disables
+	DisablePeriod   time.Duration // minimum span of those failures
+	Concurrency     int           // delivery requests in flight at once
+	PollInterval    time.Duration // how often the delivery worker polls when idle
+	LogRetention    time.Duration // how long delivery attempts stay listed; 0 keeps them forever
+
+	AllowPrivateTargets bool // accept and deliver to loopback, link-local and private addresses
+}
+
+type PositionConfig struct {
+	RebalanceInterval time.Duration // how often the manual order is checked for long keys; 0 disables it
+}
+
+type ReminderConfig struct {
+	PollInterval time.Duration // how often due reminders are fired; 0 disables the scheduler
+}
+
+type WorkflowConfig struct {
+	Statuses     []string // task statuses in board order; new tasks start in the first
+	DoneStatuses []string // statuses that complete a task
+	Transitions  []string // allowed moves, each "from>to" with "*" for any status
+}
+
 // Config holds application configuration
 type Config struct {
-	AppConfig AppConfig
-	SConfig   ServerConfig
-	DB        database.Config
+	AppConfig  AppConfig
+	SConfig    ServerConfig
+	DB         database.Config
+	Pagination PaginationConfig
+	Trash      TrashConfig
+	Watch      WatchConfig
+	Outbox     OutboxConfig
+	Webhooks   WebhookConfig
+	Reminders  ReminderConfig
+	Positions  PositionConfig
+	Workflow   WorkflowConfig
 }
 
 // LoadConfig loads configuration from environment variables
@@ -31,6 +91,13 @@ func LoadConfig() Config {
 	loadEnvFile()
 
 	dbPort, _ := strconv.Atoi(getEnv("DB_PORT", "5432"))
+	autoMigrate, _ := strconv.ParseBool(getEnv("DB_AUTO_MIGRATE", "true"))
+	outboxBatchSize, _ := strconv.Atoi(getEnv("OUTBOX_BATCH_SIZE", "100"))
+	outboxMaxAttempts, _ := strconv.Atoi(getEnv("OUTBOX_MAX_ATTEMPTS", "12"))
+	webhookMaxAttempts, _ := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "15"))
+	webhookDisableFailures, _ := strconv.Atoi(getEnv("WEBHOOK_DISABLE_FAILURES", "20"))
+	webhookConcurrency, _ := strconv.Atoi(getEnv("WEBHOOK_CONCURRENCY", "8"))
+	webhookAllowPrivate, _ := strconv.ParseBool(getEnv("WEBHOOK_ALLOW_PRIVATE_TARGETS", "false"))
 
 	return Config{
 		AppConfig: AppConfig{
@@ -41,12 +108,59 @@ func LoadConfig() Config {
 			ServerName: getEnv("SERVER_NAME", "localhost"),
 		},
 		DB: database.Config{
+			Driver:   getEnv("DB_DRIVER", database.DriverPostgres),
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     dbPort,
 			Username: getEnv("DB_USER", "postgres"),
 			Password: getEnv("DB_PASSWORD", "password"),
 			DBName:   getEnv("DB_NAME", "tasklist"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+
+			AutoMigrate: autoMigrate,
+		},
+		Pagination: PaginationConfig{
+			TokenSecret: getEnv("PAGE_TOKEN_SECRET", ""),
+			TokenTTL:    getDuration("PAGE_TOKEN_TTL", 24*time.Hour),
+		},
+		Trash: TrashConfig{
+			Retention:     getDuration("TRASH_RETENTION", 30*24*time.Hour),
+			PurgeInterval: getDuration("TRASH_PURGE_INTERVAL", time.Hour),
+		},
+		Watch: WatchConfig{
+			HistoryRetention: getDuration("WATCH_HISTORY_RETENTION", 24*time.Hour),
+			PruneInterval:    getDuration("WATCH_PRUNE_INTERVAL", 10*time.Minute),
+		},
+		Outbox: OutboxConfig{
+			Sinks:          getList("OUTBOX_SINKS", ""),
+			FilePath:       getEnv("OUTBOX_FILE", "outbox.jsonl"),
+			WebhookURL:     getEnv("OUTBOX_WEBHOOK_URL", ""),
+			WebhookTimeout: getDuration("OUTBOX_WEBHOOK_TIMEOUT", 10*time.Second),
+			PollInterval:   getDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:      outboxBatchSize,
+			MaxAttempts:    outboxMaxAttempts,
+		},
+		Webhooks: WebhookConfig{
+			Timeout:         getDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:     webhookMaxAttempts,
+			DisableFailures: webhookDisableFailures,
+			DisablePeriod:   getDuration("WEBHOOK_DISABLE_PERIOD", 24*time.Hour),
+			Concurrency:     webhookConcurrency,
+			PollInterval:    getDuration("WEBHOOK_POLL_INTERVAL", time.Second),
+			LogRetention:    getDuration("WEBHOOK_LOG_RETENTION", 7*24*time.Hour),
+
+			AllowPrivateTargets: webhookAllowPrivate,
+		},
+		Reminders: ReminderConfig{
+			PollInterval: getDuration("REMINDER_POLL_INTERVAL", 30*time.Second),
+		},
+		Positions: PositionConfig{
+			RebalanceInterval: getDuration("POSITION_REBALANCE_INTERVAL", 10*time.Minute),
+		},
+		Workflow: WorkflowConfig{
+			Statuses:     getList("TASK_STATUSES", "todo,in_progress,in_review,done,wont_do"),
+			DoneStatuses: getList("TASK_DONE_STATUSES", "done,wont_do"),
+			Transitions: getList("TASK_TRANSITIONS",
+				"todo>in_progress,in_progress>todo,in_progress>in_review,in_review>in_progress,*>done,*>wont_do,done>todo,wont_do>todo"),
 		},
 	}
 }
@@ -97,3 +211,23 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// getDuration parses a duration environment variable, falling back to defaultValue
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, ""))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// getList splits a comma-separated environment variable, dropping empty items
+func getList(key, defaultValue string) []string {
+	var items []string
+	for _, item := range strings.Split(getEnv(key, defaultValue), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// watcherBuffer is how many undelivered events a watcher may fall behind by
+// before it is dropped with ErrLagged
+const watcherBuffer = 256
+
+// changeFeed fans task events out to in-process watchers. When limit is positive it
+// also keeps at least the limit most recent events so watchers can resume from a
+// cursor; stores with their own change history pass 0.
+type changeFeed struct {
+	mu       sync.Mutex
+	watchers map[chan models.TaskEvent]struct{}
+	history  []models.TaskEvent
+	limit    int
+	lastSeq  int64
+}
+
+// newChangeFeed creates a change feed retaining at least limit events
+func newChangeFeed(limit int) *changeFeed {
+	return &changeFeed{
+		watchers: make(map[chan models.TaskEvent]struct{}),
+		limit:    limit,
+	}
+}
+
+// publish delivers ev to every watcher, dropping watchers that have fallen behind
+func (f *changeFeed) publish(ev models.TaskEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastSeq = max(f.lastSeq, ev.Seq)
+	if f.limit > 0 {
+		// Trim in bulk so publishing stays amortized O(1)
+		if len(f.history) >= 2*f.limit {
+			f.history = append(f.history[:0], f.history[len(f.history)-f.limit:]...)
+		}
+		f.history = append(f.history, ev)
+	}
+
+	for ch := range f.watchers {
+		select {
+		case ch <- ev:
+		default:
+			delete(f.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+// since returns the retained events after seq, or ErrExpired if some were dropped
+func (f *changeFeed) since(seq int64) ([]models.TaskEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if seq > f.lastSeq || (len(f.history) > 0 && f.history[0].Seq > seq+1) ||
+		(len(f.history) == 0 && seq < f.lastSeq) {
+		return nil, ErrExpired
+	}
+
+	var events []models.TaskEvent
+	for _, ev := range f.history {
+		if ev.Seq > seq {
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+// prune drops retained events that occurred before cutoff, always keeping the latest
+func (f *changeFeed) prune(cutoff time.Time) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := 0
+	for n < len(f.history)-1 && f.history[n].OccurredAt.Before(cutoff) {
+		n++
+	}
+	f.history = append(f.history[:0], f.history[n:]...)
+	return int64(n)
+}
+
+// watch calls fn for every event after seq until ctx is done or fn fails. A zero seq
+// starts with the next event; otherwise backlog loads the events missed since seq.
+func (f *changeFeed) watch(ctx context.Context, seq int64, backlog func(seq int64) ([]models.TaskEvent, error), fn func(models.TaskEvent) error) error {
+	// Subscribe before loading the backlog so nothing published in between is lost
+	ch := make(chan models.TaskEvent, watcherBuffer)
+	f.mu.Lock()
+	f.watchers[ch] = struct{}{}
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		if _, ok := f.watchers[ch]; ok {
+			delete(f.watchers, ch)
+			close(ch)
+		}
+		f.mu.Unlock()
+	}()
+
+	// Events may arrive both in the backlog and live; deliver each once
+	delivered := make(map[int64]bool)
+	if seq > 0 {
+		events, err := backlog(seq)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if err := fn(ev); err != nil {
+				return err
+			}
+			delivered[ev.Seq] = true
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-ch:
+			if !ok {
+				return ErrLagged
+			}
+			if delivered[ev.Seq] {
+				delete(delivered, ev.Seq)
+				continue
+			}
+			if err := fn(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
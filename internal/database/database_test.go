@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// testWorkflow returns the default workflow from the server config
+func testWorkflow(t *testing.T) *models.Workflow {
+	t.Helper()
+	workflow, err := models.NewWorkflow(
+		[]string{"todo", "in_progress", "in_review", "done", "wont_do"},
+		[]string{"done", "wont_do"},
+		[]string{"todo>in_progress", "in_progress>todo", "in_progress>in_review", "in_review>in_progress",
+			"*>done", "*>wont_do", "done>todo", "wont_do>todo"})
+	if err != nil {
+		t.Fatalf("NewWorkflow: %v", err)
+	}
+	return workflow
+}
+
+// newTestTask returns a task ready to be created
+func newTestTask(title string) *models.Task {
+	now := time.Now()
+	return &models.Task{ID: uuid.New().String(), Title: title, CreatedAt: now, UpdatedAt: now, Version: 1}
+}
+
+// openTestDB connects to the scratch database in TEST_DATABASE_URL and migrates it to
+// the latest version, skipping the test when the variable is not set
+func openTestDB(t *testing.T) *PostgresDB {
+	t.Helper()
+	connStr := os.Getenv("TEST_DATABASE_URL")
+	if connStr == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	db, err := sqlx.Connect("postgres", connStr)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	pg := &PostgresDB{DB: db, connStr: connStr}
+	t.Cleanup(func() { pg.Close() })
+
+	migrator, err := NewMigrator(pg)
+	if err != nil {
+		t.Fatalf("NewMigrator: %v", err)
+	}
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	return pg
+}
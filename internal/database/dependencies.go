@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+)
+
+// dependencyLockKey is the pg_advisory_xact_lock key that serializes adding
+// dependencies, so two concurrent additions cannot close a cycle the other did not see
+const dependencyLockKey int64 = 0x626c6f636b000000 // "block"
+
+// AddDependency makes a task blocked by another if its version matches
+func (r *TaskRepository) AddDependency(ctx context.Context, req *models.DependencyRequest) (*models.Task, error) {
+	query := `
+    INSERT INTO task_dependencies (task_id, blocker_id) VALUES ($1, $2)
+    ON CONFLICT DO NOTHING`
+	return r.redependTask(ctx, req, query, true)
+}
+
+// RemoveDependency stops a task being blocked by another if its version matches
+func (r *TaskRepository) RemoveDependency(ctx context.Context, req *models.DependencyRequest) (*models.Task, error) {
+	query := `DELETE FROM task_dependencies WHERE task_id = $1 AND blocker_id = $2`
+	return r.redependTask(ctx, req, query, false)
+}
+
+// ListTasksInDependencyOrder lists live tasks with each after its blockers among them
+func (r *TaskRepository) ListTasksInDependencyOrder(ctx context.Context, req *models.ListTasksInDependencyOrderRequest) ([]*models.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks
+    WHERE deleted_at IS NULL AND ($1 = '' OR project_id = $1) AND ($2 OR NOT completed)`
+
+	var tasks []*models.Task
+	if err := r.db.SelectContext(ctx, &tasks, query, req.ProjectID, req.IncludeCompleted); err != nil {
+		return nil, wrapError(ctx, "list tasks in dependency order", err)
+	}
+	return models.DependencyOrder(tasks), nil
+}
+
+// redependTask runs query with the task and blocker IDs of req and records a change if
+// it touched a row. With checkBlocker, the blocker must be live and must not depend on
+// the task.
+func (r *TaskRepository) redependTask(ctx context.Context, req *models.DependencyRequest, query string, checkBlocker bool) (*models.Task, error) {
+	var task *models.Task
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockTask(ctx, tx, req.TaskID, false, req.Version)
+		if err != nil {
+			return err
+		}
+
+		if checkBlocker {
+			if err := checkDependency(ctx, tx, req.TaskID, req.BlockerID); err != nil {
+				return err
+			}
+		}
+
+		result, err := tx.ExecContext(ctx, query, req.TaskID, req.BlockerID)
+		if err != nil {
+			return wrapError(ctx, "change dependencies of task "+req.TaskID, err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if n == 0 {
+			// Nothing changed, so keep the version
+			task = before
+			return nil
+		}
+
+		task, err = applyChange(ctx, tx, models.ChangeUpdated, before, touchTaskQuery, req.TaskID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// checkDependency checks inside tx that task id may be blocked by blockerID: the
+// blocker must be live and not blocked by id, directly or through other tasks. Checks
+// are serialized until tx ends, so concurrent additions cannot form a cycle.
+func checkDependency(ctx context.Context, tx *sqlx.Tx, id, blockerID string) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, dependencyLockKey); err != nil {
+		return wrapError(ctx, "lock task dependencies", err)
+	}
+
+	// Trashed blockers still count: restoring them must not complete a cycle
+	query := `
+    WITH RECURSIVE blockers (id) AS (
+        SELECT $1::text
+        UNION
+        SELECT d.blocker_id FROM task_dependencies d JOIN blockers b ON d.task_id = b.id
+    )
+    SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND deleted_at IS NULL) AS found,
+        EXISTS (SELECT 1 FROM blockers WHERE id = $2) AS cycle`
+
+	var check struct {
+		Found bool `db:"found"`
+		Cycle bool `db:"cycle"`
+	}
+	if err := tx.GetContext(ctx, &check, query, blockerID, id); err != nil {
+		return wrapError(ctx, "check blocker of task "+id, err)
+	}
+	if !check.Found {
+		return fmt.Errorf("task with ID %s: %w", blockerID, ErrBlockerNotFound)
+	}
+	if check.Cycle {
+		return fmt.Errorf("task with ID %s is blocked by task %s: %w", blockerID, id, ErrDependencyCycle)
+	}
+	return nil
+}
+
+// checkBlocked fails with ErrTaskBlocked if an update of fields (all when nil) from
+// before to after completes a blocked task, unless ignoreBlockers is set
+func checkBlocked(before, after *models.Task, fields []string, ignoreBlockers bool) error {
+	if ignoreBlockers || !before.Blocked || before.Completed || !after.Completed {
+		return nil
+	}
+	if fields != nil && !slices.Contains(fields, models.FieldCompleted) && !slices.Contains(fields, models.FieldStatus) {
+		return nil
+	}
+	return fmt.Errorf("task with ID %s has blockers that are not completed: %w", before.ID, ErrTaskBlocked)
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Sentinel errors returned by every TaskStore. Callers match them with errors.Is;
+// the wrapped driver error, if any, is kept for logging.
+var (
+	// ErrNotFound is returned when the requested task does not exist
+	ErrNotFound = errors.New("task not found")
+	// ErrAlreadyExists is returned when a task with the same ID already exists
+	ErrAlreadyExists = errors.New("task already exists")
+	// ErrConflict is returned when the stored task version differs from the expected one,
+	// or the database aborted the transaction because of a concurrent write or delete
+	ErrConflict = errors.New("task version conflict")
+	// ErrUnavailable is returned when the database cannot be reached; the call may be retried
+	ErrUnavailable = errors.New("database unavailable")
+	// ErrInvalid is returned when the database rejects the data as invalid
+	ErrInvalid = errors.New("invalid task data")
+	// ErrExpired is returned when a watch cursor points before the retained change history
+	ErrExpired = errors.New("change history no longer available")
+	// ErrLagged is returned when a watcher falls too far behind the change feed;
+	// it may resume from the last cursor it received
+	ErrLagged = errors.New("watcher fell behind the change feed")
+	// ErrWebhookNotFound is returned when the requested webhook does not exist
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrLabelNotFound is returned when a requested label does not exist
+	ErrLabelNotFound = errors.New("label not found")
+	// ErrLabelExists is returned when another label already has the name, ignoring case
+	ErrLabelExists = errors.New("label name already taken")
+	// ErrProjectNotFound is returned when a requested project does not exist
+	ErrProjectNotFound = errors.New("project not found")
+	// ErrProjectNotEmpty is returned when deleting a project that still has tasks
+	// without saying what should happen to them
+	ErrProjectNotEmpty = errors.New("project still has tasks")
+	// ErrParentNotFound is returned when the parent given for a subtask is missing or trashed
+	ErrParentNotFound = errors.New("parent task not found")
+	// ErrTaskCycle is returned when a task would become a subtask of itself or of one of
+	// its own subtasks
+	ErrTaskCycle = errors.New("task would be its own ancestor")
+	// ErrBlockerNotFound is returned when the blocker given for a dependency is missing or trashed
+	ErrBlockerNotFound = errors.New("blocker task not found")
+	// ErrDependencyCycle is returned when a task would be blocked by itself, directly or not
+	ErrDependencyCycle = errors.New("dependency would form a cycle")
+	// ErrTaskBlocked is returned when completing a task whose blockers are not all completed
+	ErrTaskBlocked = errors.New("task is blocked")
+	// ErrInvalidTransition is returned when the workflow does not allow moving a task
+	// from its status to the requested one
+	ErrInvalidTransition = errors.New("status transition not allowed")
+)
+
+// PostgreSQL error codes and classes used by classifyError
+const (
+	uniqueViolation      = "23505"
+	foreignKeyViolation  = "23503"
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+	queryCanceled        = "57014"
+	adminShutdown        = "57P01"
+	cannotConnectNow     = "57P03"
+
+	classDataException        = "22"
+	classIntegrityViolation   = "23"
+	classConnectionException  = "08"
+	classInsufficientResource = "53"
+)
+
+// wrapError describes a failed operation and tags the driver error with the matching
+// sentinel, so callers can use errors.Is without knowing about lib/pq
+func wrapError(ctx context.Context, op string, err error) error {
+	if sentinel := classifyError(ctx, err); sentinel != nil {
+		return fmt.Errorf("failed to %s: %w: %w", op, sentinel, err)
+	}
+	return fmt.Errorf("failed to %s: %w", op, err)
+}
+
+// classifyError maps a driver error to a sentinel or context error, or nil if it has none
+func classifyError(ctx context.Context, err error) error {
+	// A cancelled statement reports the reason through the caller's context
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case uniqueViolation:
+			return ErrAlreadyExists
+		case serializationFailure, deadlockDetected:
+			return ErrConflict
+		case foreignKeyViolation:
+			// The checks before the write passed, so a concurrent delete removed the row
+			return ErrConflict
+		case queryCanceled:
+			// The caller's context is live, so the server gave up: statement_timeout and the like
+			return context.DeadlineExceeded
+		case adminShutdown, cannotConnectNow:
+			return ErrUnavailable
+		}
+
+		switch pqErr.Code.Class() {
+		case classConnectionException, classInsufficientResource:
+			return ErrUnavailable
+		case classDataException, classIntegrityViolation:
+			return ErrInvalid
+		}
+		return nil
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		strings.Contains(err.Error(), "connection refused") {
+		return ErrUnavailable
+	}
+	return nil
+}
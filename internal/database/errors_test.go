@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestClassifyError(t *testing.T) {
+	live := context.Background()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want error
+	}{
+		{"unique violation", live, &pq.Error{Code: uniqueViolation}, ErrAlreadyExists},
+		{"serialization failure", live, &pq.Error{Code: serializationFailure}, ErrConflict},
+		{"deadlock", live, &pq.Error{Code: deadlockDetected}, ErrConflict},
+		{"foreign key violation", live, &pq.Error{Code: foreignKeyViolation}, ErrConflict},
+		{"check violation", live, &pq.Error{Code: "23514"}, ErrInvalid},
+		{"invalid text", live, &pq.Error{Code: "22P02"}, ErrInvalid},
+		{"statement timeout", live, &pq.Error{Code: queryCanceled}, context.DeadlineExceeded},
+		{"cancelled by the caller", canceled, &pq.Error{Code: queryCanceled}, context.Canceled},
+		{"admin shutdown", live, &pq.Error{Code: adminShutdown}, ErrUnavailable},
+		{"too many connections", live, &pq.Error{Code: "53300"}, ErrUnavailable},
+		{"connection failure", live, &pq.Error{Code: "08006"}, ErrUnavailable},
+		{"bad connection", live, fmt.Errorf("query: %w", driver.ErrBadConn), ErrUnavailable},
+		{"closed connection", live, io.ErrUnexpectedEOF, ErrUnavailable},
+		{"syntax error", live, &pq.Error{Code: "42601"}, nil},
+		{"plain error", live, errors.New("boom"), nil},
+	}
+	for _, tt := range tests {
+		got := classifyError(tt.ctx, tt.err)
+		if (tt.want == nil && got != nil) || (tt.want != nil && !errors.Is(got, tt.want)) {
+			t.Errorf("%s: classifyError = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// labelColumns is the column list scanned into models.Label
+const labelColumns = `id, name, color, created_at`
+
+// touchTaskQuery gives a task a new version after its labels changed
+const touchTaskQuery = `
+    UPDATE tasks SET updated_at = now(), version = version + 1
+    WHERE id = $1 AND deleted_at IS NULL`
+
+// CreateLabel adds a new label
+func (r *TaskRepository) CreateLabel(ctx context.Context, label *models.Label) error {
+	query := `INSERT INTO labels (id, name, color, created_at) VALUES ($1, $2, $3, $4)`
+
+	label.CreatedAt = label.CreatedAt.Truncate(time.Microsecond)
+	if _, err := r.db.ExecContext(ctx, query, label.ID, label.Name, label.Color, label.CreatedAt); err != nil {
+		return wrapLabelError(ctx, "create label "+label.ID, label.Name, err)
+	}
+	return nil
+}
+
+// UpdateLabel renames or recolors a label
+func (r *TaskRepository) UpdateLabel(ctx context.Context, req *models.UpdateLabelRequest) (*models.Label, error) {
+	var set []string
+	args := []interface{}{req.ID}
+	for _, field := range req.Fields() {
+		switch field {
+		case models.FieldLabelName:
+			args = append(args, req.Name)
+		case models.FieldLabelColor:
+			args = append(args, req.Color)
+		default:
+			return nil, fmt.Errorf("cannot update unknown label field %q: %w", field, ErrInvalid)
+		}
+		set = append(set, fmt.Sprintf("%s = $%d", field, len(args)))
+	}
+	query := `UPDATE labels SET ` + strings.Join(set, ", ") + ` WHERE id = $1 RETURNING ` + labelColumns
+
+	var label models.Label
+	if err := r.db.GetContext(ctx, &label, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("label with ID %s: %w", req.ID, ErrLabelNotFound)
+		}
+		return nil, wrapLabelError(ctx, "update label "+req.ID, req.Name, err)
+	}
+	return &label, nil
+}
+
+// DeleteLabel removes a label; it comes off every task by cascade
+func (r *TaskRepository) DeleteLabel(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM labels WHERE id = $1`, id)
+	if err != nil {
+		return wrapError(ctx, "delete label "+id, err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return wrapError(ctx, "delete label "+id, err)
+	} else if n == 0 {
+		return fmt.Errorf("label with ID %s: %w", id, ErrLabelNotFound)
+	}
+	return nil
+}
+
+// ListLabels lists every label by name
+func (r *TaskRepository) ListLabels(ctx context.Context) ([]*models.Label, error) {
+	var labels []*models.Label
+	query := `SELECT ` + labelColumns + ` FROM labels ORDER BY name, id`
+	if err := r.db.SelectContext(ctx, &labels, query); err != nil {
+		return nil, wrapError(ctx, "list labels", err)
+	}
+	return labels, nil
+}
+
+// AddTaskLabels attaches labels to a task if its version matches
+func (r *TaskRepository) AddTaskLabels(ctx context.Context, req *models.TaskLabelsRequest) (*models.Task, error) {
+	query := `
+    INSERT INTO task_labels (task_id, label_id)
+    SELECT $1, unnest($2::text[])
+    ON CONFLICT DO NOTHING`
+	return r.relabelTask(ctx, req, query, true)
+}
+
+// RemoveTaskLabels detaches labels from a task if its version matches
+func (r *TaskRepository) RemoveTaskLabels(ctx context.Context, req *models.TaskLabelsRequest) (*models.Task, error) {
+	query := `DELETE FROM task_labels WHERE task_id = $1 AND label_id = ANY($2)`
+	return r.relabelTask(ctx, req, query, false)
+}
+
+// relabelTask runs query with the task and label IDs of req and records a change if it
+// touched any rows. With checkLabels, every label must exist.
+func (r *TaskRepository) relabelTask(ctx context.Context, req *models.TaskLabelsRequest, query string, checkLabels bool) (*models.Task, error) {
+	var task *models.Task
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockTask(ctx, tx, req.TaskID, false, req.Version)
+		if err != nil {
+			return err
+		}
+
+		if checkLabels {
+			var found []string
+			err := tx.SelectContext(ctx, &found, `SELECT id FROM labels WHERE id = ANY($1)`, pq.Array(req.LabelIDs))
+			if err != nil {
+				return wrapError(ctx, "get labels", err)
+			}
+			for _, id := range req.LabelIDs {
+				if !slices.Contains(found, id) {
+					return fmt.Errorf("label with ID %s: %w", id, ErrLabelNotFound)
+				}
+			}
+		}
+
+		result, err := tx.ExecContext(ctx, query, req.TaskID, pq.Array(req.LabelIDs))
+		if err != nil {
+			return wrapError(ctx, "change labels of task "+req.TaskID, err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if n == 0 {
+			// Nothing changed, so keep the version
+			task = before
+			return nil
+		}
+
+		task, err = applyChange(ctx, tx, models.ChangeUpdated, before, touchTaskQuery, req.TaskID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// wrapLabelError is wrapError for label writes, reporting a taken name as ErrLabelExists
+func wrapLabelError(ctx context.Context, op, name string, err error) error {
+	err = wrapError(ctx, op, err)
+	if errors.Is(err, ErrAlreadyExists) {
+		return fmt.Errorf("label named %q: %w: %w", name, ErrLabelExists, err)
+	}
+	return err
+}
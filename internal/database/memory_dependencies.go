@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// AddDependency makes a task blocked by another if its version matches
+func (s *MemoryTaskStore) AddDependency(ctx context.Context, req *models.DependencyRequest) (*models.Task, error) {
+	return s.redependTask(ctx, req, func(blockedBy models.IDList) (models.IDList, error) {
+		if _, ok := s.live(req.BlockerID); !ok {
+			return nil, fmt.Errorf("task with ID %s: %w", req.BlockerID, ErrBlockerNotFound)
+		}
+		if s.dependsOn(req.BlockerID, req.TaskID) {
+			return nil, fmt.Errorf("task with ID %s is blocked by task %s: %w", req.BlockerID, req.TaskID, ErrDependencyCycle)
+		}
+		if !slices.Contains(blockedBy, req.BlockerID) {
+			blockedBy = append(blockedBy, req.BlockerID)
+		}
+		return blockedBy, nil
+	})
+}
+
+// RemoveDependency stops a task being blocked by another if its version matches
+func (s *MemoryTaskStore) RemoveDependency(ctx context.Context, req *models.DependencyRequest) (*models.Task, error) {
+	return s.redependTask(ctx, req, func(blockedBy models.IDList) (models.IDList, error) {
+		return slices.DeleteFunc(blockedBy, func(id string) bool {
+			return id == req.BlockerID
+		}), nil
+	})
+}
+
+// ListTasksInDependencyOrder lists live tasks with each after its blockers among them
+func (s *MemoryTaskStore) ListTasksInDependencyOrder(ctx context.Context, req *models.ListTasksInDependencyOrderRequest) ([]*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	var tasks []*models.Task
+	for _, task := range s.tasks {
+		if task.DeletedAt != nil || (req.ProjectID != "" && task.ProjectID != req.ProjectID) ||
+			(task.Completed && !req.IncludeCompleted) {
+			continue
+		}
+		tasks = append(tasks, cloneTask(task))
+	}
+	s.mu.RUnlock()
+
+	return models.DependencyOrder(tasks), nil
+}
+
+// redependTask replaces a task's blockers with what change makes of a copy of them and
+// records a change unless the set stayed the same
+func (s *MemoryTaskStore) redependTask(ctx context.Context, req *models.DependencyRequest, change func(models.IDList) (models.IDList, error)) (*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.live(req.TaskID)
+	if !ok {
+		return nil, fmt.Errorf("task with ID %s: %w", req.TaskID, ErrNotFound)
+	}
+	if req.Version != 0 && existing.Version != req.Version {
+		return nil, fmt.Errorf("task with ID %s is at version %d: %w", req.TaskID, existing.Version, ErrConflict)
+	}
+
+	blockedBy, err := change(slices.Clone(existing.BlockedBy))
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(blockedBy)
+	if slices.Equal(blockedBy, existing.BlockedBy) {
+		// Nothing changed, so keep the version
+		return cloneTask(existing), nil
+	}
+
+	updated := cloneTask(existing)
+	updated.BlockedBy = blockedBy
+	updated.Blocked = s.blocked(updated)
+	updated.UpdatedAt = time.Now()
+	updated.Version++
+	s.tasks[req.TaskID] = updated
+	s.record(ctx, models.ChangeUpdated, existing, updated)
+	s.flush()
+	return cloneTask(updated), nil
+}
+
+// dependsOn reports whether task id is blocked by blockerID, directly or through other
+// tasks, trashed ones included. Callers must hold s.mu.
+func (s *MemoryTaskStore) dependsOn(id, blockerID string) bool {
+	seen := make(map[string]bool)
+	next := []string{id}
+	for len(next) > 0 {
+		task, ok := s.tasks[next[0]]
+		next = next[1:]
+		if !ok {
+			continue
+		}
+		for _, blocker := range task.BlockedBy {
+			if blocker == blockerID {
+				return true
+			}
+			if !seen[blocker] {
+				seen[blocker] = true
+				next = append(next, blocker)
+			}
+		}
+	}
+	return false
+}
+
+// blocked reports whether a live task in task.BlockedBy is not completed.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) blocked(task *models.Task) bool {
+	for _, id := range task.BlockedBy {
+		if blocker, ok := s.live(id); ok && !blocker.Completed {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshBlocked updates Blocked on the tasks blocked by the task with id, without
+// recording changes. Callers must hold s.mu.
+func (s *MemoryTaskStore) refreshBlocked(id string) {
+	for taskID, task := range s.tasks {
+		if !slices.Contains(task.BlockedBy, id) {
+			continue
+		}
+		if blocked := s.blocked(task); blocked != task.Blocked {
+			refreshed := cloneTask(task)
+			refreshed.Blocked = blocked
+			s.tasks[taskID] = refreshed
+		}
+	}
+}
+
+// dropPurgedBlockers removes tasks that no longer exist from every task's blockers,
+// without recording changes. Callers must hold s.mu.
+func (s *MemoryTaskStore) dropPurgedBlockers() {
+	purged := func(id string) bool {
+		_, ok := s.tasks[id]
+		return !ok
+	}
+	for taskID, task := range s.tasks {
+		if !slices.ContainsFunc(task.BlockedBy, purged) {
+			continue
+		}
+		pruned := cloneTask(task)
+		pruned.BlockedBy = slices.DeleteFunc(pruned.BlockedBy, purged)
+		s.tasks[taskID] = pruned
+	}
+}
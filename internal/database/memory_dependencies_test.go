@@ -0,0 +1,198 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// newTestTasks creates a top-level task for each title, in that order
+func newTestTasks(t *testing.T, store *MemoryTaskStore, titles ...string) []*models.Task {
+	t.Helper()
+	tasks := make([]*models.Task, len(titles))
+	for i, title := range titles {
+		tasks[i] = newTestTask(title)
+		if err := store.CreateTask(context.Background(), tasks[i]); err != nil {
+			t.Fatalf("CreateTask(%q): %v", title, err)
+		}
+	}
+	return tasks
+}
+
+// block makes task blocked by blocker, failing the test on error
+func block(t *testing.T, store *MemoryTaskStore, task, blocker *models.Task) *models.Task {
+	t.Helper()
+	blocked, err := store.AddDependency(context.Background(), &models.DependencyRequest{TaskID: task.ID, BlockerID: blocker.ID})
+	if err != nil {
+		t.Fatalf("AddDependency(%s blocked by %s): %v", task.Title, blocker.Title, err)
+	}
+	return blocked
+}
+
+// isBlocked reports the stored Blocked flag of the task with id
+func isBlocked(t *testing.T, store *MemoryTaskStore, id string) bool {
+	t.Helper()
+	task, err := store.GetTask(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	return task.Blocked
+}
+
+func TestAddDependency(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	tasks := newTestTasks(t, store, "a", "b", "c", "trashed")
+	a, b, c, trashed := tasks[0], tasks[1], tasks[2], tasks[3]
+	if err := store.DeleteTask(ctx, trashed.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	blocked := block(t, store, a, b)
+	if !blocked.Blocked || !slices.Equal(blocked.BlockedBy, []string{b.ID}) || blocked.Version != a.Version+1 {
+		t.Errorf("a after AddDependency: blocked %t by %q at version %d; want blocked by b at version %d",
+			blocked.Blocked, blocked.BlockedBy, blocked.Version, a.Version+1)
+	}
+	if again := block(t, store, a, b); again.Version != blocked.Version {
+		t.Errorf("adding the same dependency again moved a to version %d", again.Version)
+	}
+	block(t, store, c, a)
+
+	tests := []struct {
+		name    string
+		task    *models.Task
+		blocker string
+		version int64
+		want    error
+	}{
+		{"direct cycle", b, a.ID, 0, ErrDependencyCycle},
+		{"transitive cycle", b, c.ID, 0, ErrDependencyCycle},
+		{"missing blocker", a, "missing", 0, ErrBlockerNotFound},
+		{"trashed blocker", a, trashed.ID, 0, ErrBlockerNotFound},
+		{"trashed task", trashed, a.ID, 0, ErrNotFound},
+		{"stale version", a, c.ID, a.Version, ErrConflict},
+	}
+	for _, tt := range tests {
+		req := &models.DependencyRequest{TaskID: tt.task.ID, BlockerID: tt.blocker, Version: tt.version}
+		if _, err := store.AddDependency(ctx, req); !errors.Is(err, tt.want) {
+			t.Errorf("%s: AddDependency = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// A trashed task in the chain still counts, so restoring it cannot complete a cycle
+	if err := store.DeleteTask(ctx, a.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if _, err := store.AddDependency(ctx, &models.DependencyRequest{TaskID: b.ID, BlockerID: c.ID}); !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("cycle through a trashed task: AddDependency = %v, want ErrDependencyCycle", err)
+	}
+
+	// Once the chain is broken the dependency is allowed
+	if _, err := store.RemoveDependency(ctx, &models.DependencyRequest{TaskID: c.ID, BlockerID: a.ID}); err != nil {
+		t.Fatalf("RemoveDependency: %v", err)
+	}
+	block(t, store, b, c)
+}
+
+func TestCompleteBlockedTask(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	tasks := newTestTasks(t, store, "blocked", "blocker", "forced", "other blocked")
+	task, blocker, forced, other := tasks[0], tasks[1], tasks[2], tasks[3]
+	*task = *block(t, store, task, blocker)
+	*forced = *block(t, store, forced, blocker)
+	block(t, store, other, blocker)
+
+	complete := func(task *models.Task, ignoreBlockers bool) error {
+		done := *task
+		done.Completed = true
+		return store.UpdateTask(ctx, &done, []string{models.FieldCompleted}, ignoreBlockers)
+	}
+	if err := complete(task, false); !errors.Is(err, ErrTaskBlocked) {
+		t.Errorf("completing a blocked task = %v, want ErrTaskBlocked", err)
+	}
+	if _, err := store.TransitionTask(ctx, &models.TransitionTaskRequest{ID: task.ID, Status: "done"}); !errors.Is(err, ErrTaskBlocked) {
+		t.Errorf("moving a blocked task to done = %v, want ErrTaskBlocked", err)
+	}
+
+	// Edits that do not complete the task are still allowed
+	renamed := *task
+	renamed.Title = "still blocked"
+	if err := store.UpdateTask(ctx, &renamed, []string{models.FieldTitle}, false); err != nil {
+		t.Errorf("renaming a blocked task: %v", err)
+	}
+
+	if err := complete(forced, true); err != nil {
+		t.Errorf("completing a blocked task with IgnoreBlockers: %v", err)
+	}
+	if _, err := store.TransitionTask(ctx, &models.TransitionTaskRequest{ID: other.ID, Status: "done", IgnoreBlockers: true}); err != nil {
+		t.Errorf("moving a blocked task to done with IgnoreBlockers: %v", err)
+	}
+
+	// Completing, trashing and restoring the blocker keeps the flag in step
+	if err := store.DeleteTask(ctx, blocker.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if isBlocked(t, store, task.ID) {
+		t.Error("task is still blocked after its blocker was trashed")
+	}
+	restored, err := store.RestoreTask(ctx, blocker.ID, 0)
+	if err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+	if !isBlocked(t, store, task.ID) {
+		t.Error("task is not blocked after its blocker was restored")
+	}
+	if err := complete(restored, false); err != nil {
+		t.Fatalf("completing the blocker: %v", err)
+	}
+	if isBlocked(t, store, task.ID) {
+		t.Error("task is still blocked after its blocker was completed")
+	}
+	if _, err := store.TransitionTask(ctx, &models.TransitionTaskRequest{ID: task.ID, Status: "done"}); err != nil {
+		t.Errorf("completing the unblocked task: %v", err)
+	}
+}
+
+func TestListTasksInDependencyOrder(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	tasks := newTestTasks(t, store, "t1", "t2", "t3", "t4")
+	t1, t2, t3, t4 := tasks[0], tasks[1], tasks[2], tasks[3]
+	block(t, store, t1, t3)
+	block(t, store, t2, t4)
+	block(t, store, t3, t4)
+
+	order := func(req *models.ListTasksInDependencyOrderRequest) []string {
+		t.Helper()
+		list, err := store.ListTasksInDependencyOrder(ctx, req)
+		if err != nil {
+			t.Fatalf("ListTasksInDependencyOrder: %v", err)
+		}
+		titles := make([]string, len(list))
+		for i, task := range list {
+			titles[i] = task.Title
+		}
+		return titles
+	}
+
+	// Each task follows its blockers; otherwise the manual order decides
+	if got, want := order(&models.ListTasksInDependencyOrderRequest{}), []string{"t4", "t2", "t3", "t1"}; !slices.Equal(got, want) {
+		t.Errorf("dependency order = %q, want %q", got, want)
+	}
+
+	done := *t4
+	done.Completed = true
+	if err := store.UpdateTask(ctx, &done, []string{models.FieldCompleted}, false); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if got, want := order(&models.ListTasksInDependencyOrderRequest{}), []string{"t2", "t3", "t1"}; !slices.Equal(got, want) {
+		t.Errorf("dependency order without completed tasks = %q, want %q", got, want)
+	}
+	if got, want := order(&models.ListTasksInDependencyOrderRequest{IncludeCompleted: true}), []string{"t4", "t2", "t3", "t1"}; !slices.Equal(got, want) {
+		t.Errorf("dependency order with completed tasks = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,191 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// CreateLabel adds a new label
+func (s *MemoryTaskStore) CreateLabel(ctx context.Context, label *models.Label) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.labels[label.ID]; ok {
+		return fmt.Errorf("failed to create label %s: %w", label.ID, ErrAlreadyExists)
+	}
+	if err := s.checkLabelName(label.ID, label.Name); err != nil {
+		return err
+	}
+	created := *label
+	s.labels[label.ID] = &created
+	return nil
+}
+
+// UpdateLabel renames or recolors a label and the copies of it on tasks
+func (s *MemoryTaskStore) UpdateLabel(ctx context.Context, req *models.UpdateLabelRequest) (*models.Label, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.labels[req.ID]
+	if !ok {
+		return nil, fmt.Errorf("label with ID %s: %w", req.ID, ErrLabelNotFound)
+	}
+	updated := *existing
+	req.ApplyTo(&updated)
+	if err := s.checkLabelName(updated.ID, updated.Name); err != nil {
+		return nil, err
+	}
+	s.labels[req.ID] = &updated
+
+	s.relabelAll(req.ID, func(labels models.LabelList, i int) models.LabelList {
+		labels[i].Name, labels[i].Color = updated.Name, updated.Color
+		models.SortLabels(labels)
+		return labels
+	})
+
+	label := updated
+	return &label, nil
+}
+
+// DeleteLabel removes a label and takes it off every task
+func (s *MemoryTaskStore) DeleteLabel(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.labels[id]; !ok {
+		return fmt.Errorf("label with ID %s: %w", id, ErrLabelNotFound)
+	}
+	delete(s.labels, id)
+
+	s.relabelAll(id, func(labels models.LabelList, i int) models.LabelList {
+		return slices.Delete(labels, i, i+1)
+	})
+	return nil
+}
+
+// ListLabels lists every label by name
+func (s *MemoryTaskStore) ListLabels(ctx context.Context) ([]*models.Label, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	labels := make([]models.Label, 0, len(s.labels))
+	for _, label := range s.labels {
+		labels = append(labels, *label)
+	}
+	s.mu.RUnlock()
+
+	models.SortLabels(labels)
+	list := make([]*models.Label, len(labels))
+	for i := range labels {
+		list[i] = &labels[i]
+	}
+	return list, nil
+}
+
+// AddTaskLabels attaches labels to a task if its version matches
+func (s *MemoryTaskStore) AddTaskLabels(ctx context.Context, req *models.TaskLabelsRequest) (*models.Task, error) {
+	return s.relabelTask(ctx, req, func(labels models.LabelList) (models.LabelList, error) {
+		for _, id := range req.LabelIDs {
+			label, ok := s.labels[id]
+			if !ok {
+				return nil, fmt.Errorf("label with ID %s: %w", id, ErrLabelNotFound)
+			}
+			if !slices.Contains(labels.IDs(), id) {
+				labels = append(labels, models.Label{ID: label.ID, Name: label.Name, Color: label.Color})
+			}
+		}
+		return labels, nil
+	})
+}
+
+// RemoveTaskLabels detaches labels from a task if its version matches
+func (s *MemoryTaskStore) RemoveTaskLabels(ctx context.Context, req *models.TaskLabelsRequest) (*models.Task, error) {
+	return s.relabelTask(ctx, req, func(labels models.LabelList) (models.LabelList, error) {
+		return slices.DeleteFunc(labels, func(label models.Label) bool {
+			return slices.Contains(req.LabelIDs, label.ID)
+		}), nil
+	})
+}
+
+// relabelTask replaces a task's labels with what change makes of a copy of them and
+// records a change unless the set stayed the same
+func (s *MemoryTaskStore) relabelTask(ctx context.Context, req *models.TaskLabelsRequest, change func(models.LabelList) (models.LabelList, error)) (*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.live(req.TaskID)
+	if !ok {
+		return nil, fmt.Errorf("task with ID %s: %w", req.TaskID, ErrNotFound)
+	}
+	if req.Version != 0 && existing.Version != req.Version {
+		return nil, fmt.Errorf("task with ID %s is at version %d: %w", req.TaskID, existing.Version, ErrConflict)
+	}
+
+	labels, err := change(slices.Clone(existing.Labels))
+	if err != nil {
+		return nil, err
+	}
+	if slices.Equal(labels.IDs(), existing.Labels.IDs()) {
+		// Nothing changed, so keep the version
+		return cloneTask(existing), nil
+	}
+	models.SortLabels(labels)
+
+	updated := cloneTask(existing)
+	updated.Labels = labels
+	updated.UpdatedAt = time.Now()
+	updated.Version++
+	s.tasks[req.TaskID] = updated
+	s.record(ctx, models.ChangeUpdated, existing, updated)
+	s.flush()
+	return cloneTask(updated), nil
+}
+
+// relabelAll replaces every task carrying the label with id by a copy whose labels
+// are what change makes of them, given the label's index. It records no changes.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) relabelAll(id string, change func(labels models.LabelList, i int) models.LabelList) {
+	for taskID, task := range s.tasks {
+		i := slices.Index(task.Labels.IDs(), id)
+		if i < 0 {
+			continue
+		}
+		relabelled := cloneTask(task)
+		relabelled.Labels = change(relabelled.Labels, i)
+		s.tasks[taskID] = relabelled
+	}
+}
+
+// checkLabelName fails if a label other than id already has name, ignoring case.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) checkLabelName(id, name string) error {
+	for _, label := range s.labels {
+		if label.ID != id && strings.EqualFold(label.Name, name) {
+			return fmt.Errorf("label named %q: %w", name, ErrLabelExists)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// newTestLabels creates a label for each name with the ID labelID returns for it
+func newTestLabels(t *testing.T, store *MemoryTaskStore, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if err := store.CreateLabel(context.Background(), &models.Label{ID: labelID(name), Name: name}); err != nil {
+			t.Fatalf("CreateLabel(%q): %v", name, err)
+		}
+	}
+}
+
+// labelID returns the ID newTestLabels gives the label named name
+func labelID(name string) string {
+	return "label-" + name
+}
+
+func TestLabelNameUniqueness(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	newTestLabels(t, store, "Urgent", "Home")
+
+	if err := store.CreateLabel(ctx, &models.Label{ID: "other", Name: "uRGENT"}); !errors.Is(err, ErrLabelExists) {
+		t.Errorf("creating a label differing only in case = %v, want ErrLabelExists", err)
+	}
+	if err := store.CreateLabel(ctx, &models.Label{ID: labelID("Home"), Name: "Garden"}); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("creating a label with a taken ID = %v, want ErrAlreadyExists", err)
+	}
+	_, err := store.UpdateLabel(ctx, &models.UpdateLabelRequest{ID: labelID("Home"), Name: "URGENT", UpdateMask: []string{"name"}})
+	if !errors.Is(err, ErrLabelExists) {
+		t.Errorf("renaming a label to a taken name = %v, want ErrLabelExists", err)
+	}
+
+	// A label may change the case of its own name
+	renamed, err := store.UpdateLabel(ctx, &models.UpdateLabelRequest{ID: labelID("Urgent"), Name: "URGENT", UpdateMask: []string{"name"}})
+	if err != nil {
+		t.Fatalf("UpdateLabel: %v", err)
+	}
+	if renamed.Name != "URGENT" {
+		t.Errorf("renamed label is %q, want URGENT", renamed.Name)
+	}
+	if _, err := store.UpdateLabel(ctx, &models.UpdateLabelRequest{ID: "missing", Name: "x"}); !errors.Is(err, ErrLabelNotFound) {
+		t.Errorf("UpdateLabel of a missing label = %v, want ErrLabelNotFound", err)
+	}
+
+	labels, err := store.ListLabels(ctx)
+	if err != nil {
+		t.Fatalf("ListLabels: %v", err)
+	}
+	if len(labels) != 2 || labels[0].Name != "Home" || labels[1].Name != "URGENT" {
+		t.Errorf("ListLabels = %+v, want Home and URGENT", labels)
+	}
+}
+
+func TestTaskLabels(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	newTestLabels(t, store, "work", "home")
+	task := newTestTask("call the plumber")
+	if err := store.CreateTask(ctx, task); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	relabel := func(change func(context.Context, *models.TaskLabelsRequest) (*models.Task, error), version int64, names ...string) (*models.Task, error) {
+		req := &models.TaskLabelsRequest{TaskID: task.ID, Version: version}
+		for _, name := range names {
+			req.LabelIDs = append(req.LabelIDs, labelID(name))
+		}
+		return change(ctx, req)
+	}
+	wantLabels := func(got *models.Task, version int64, names ...string) {
+		t.Helper()
+		var gotNames []string
+		for _, label := range got.Labels {
+			gotNames = append(gotNames, label.Name)
+		}
+		if !slices.Equal(gotNames, names) || got.Version != version {
+			t.Errorf("task has labels %q at version %d, want %q at version %d", gotNames, got.Version, names, version)
+		}
+	}
+
+	labelled, err := relabel(store.AddTaskLabels, task.Version, "work", "home")
+	if err != nil {
+		t.Fatalf("AddTaskLabels: %v", err)
+	}
+	wantLabels(labelled, task.Version+1, "home", "work")
+
+	// Adding labels the task already has changes nothing
+	again, err := relabel(store.AddTaskLabels, 0, "work")
+	if err != nil {
+		t.Fatalf("AddTaskLabels: %v", err)
+	}
+	wantLabels(again, task.Version+1, "home", "work")
+
+	if _, err := relabel(store.AddTaskLabels, task.Version, "work"); !errors.Is(err, ErrConflict) {
+		t.Errorf("AddTaskLabels with a stale version = %v, want ErrConflict", err)
+	}
+	if _, err := relabel(store.AddTaskLabels, 0, "missing"); !errors.Is(err, ErrLabelNotFound) {
+		t.Errorf("AddTaskLabels with a missing label = %v, want ErrLabelNotFound", err)
+	}
+
+	removed, err := relabel(store.RemoveTaskLabels, labelled.Version, "home")
+	if err != nil {
+		t.Fatalf("RemoveTaskLabels: %v", err)
+	}
+	wantLabels(removed, task.Version+2, "work")
+
+	// Renaming and deleting a label updates the tasks carrying it in place
+	if _, err := store.UpdateLabel(ctx, &models.UpdateLabelRequest{ID: labelID("work"), Name: "office", Color: "#ff0000"}); err != nil {
+		t.Fatalf("UpdateLabel: %v", err)
+	}
+	got, err := store.GetTask(ctx, task.ID)
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	wantLabels(got, task.Version+2, "office")
+	if got.Labels[0].Color != "#ff0000" {
+		t.Errorf("task's copy of the label has color %q, want #ff0000", got.Labels[0].Color)
+	}
+	if err := store.DeleteLabel(ctx, labelID("work")); err != nil {
+		t.Fatalf("DeleteLabel: %v", err)
+	}
+	if got, err = store.GetTask(ctx, task.ID); err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	wantLabels(got, task.Version+2)
+}
+
+func TestListTasksLabelFilters(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	newTestLabels(t, store, "work", "home", "urgent")
+
+	tasks := map[string][]string{
+		"report":  {"work", "urgent"},
+		"meeting": {"work"},
+		"laundry": {"home"},
+		"nothing": nil,
+	}
+	for title, names := range tasks {
+		task := newTestTask(title)
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		if len(names) == 0 {
+			continue
+		}
+		req := &models.TaskLabelsRequest{TaskID: task.ID}
+		for _, name := range names {
+			req.LabelIDs = append(req.LabelIDs, labelID(name))
+		}
+		if _, err := store.AddTaskLabels(ctx, req); err != nil {
+			t.Fatalf("AddTaskLabels: %v", err)
+		}
+	}
+
+	tests := []struct {
+		filter models.TaskFilter
+		want   []string
+	}{
+		{models.TaskFilter{LabelsAny: []string{labelID("work")}}, []string{"meeting", "report"}},
+		{models.TaskFilter{LabelsAny: []string{labelID("home"), labelID("urgent")}}, []string{"laundry", "report"}},
+		{models.TaskFilter{LabelsAll: []string{labelID("work"), labelID("urgent")}}, []string{"report"}},
+		{models.TaskFilter{LabelsAll: []string{labelID("home"), labelID("work")}}, nil},
+		{models.TaskFilter{LabelsAny: []string{labelID("home"), labelID("work")}, LabelsAll: []string{labelID("urgent")}}, []string{"report"}},
+	}
+	for _, tt := range tests {
+		list, err := store.ListTasks(ctx, &models.ListTasksRequest{Filter: tt.filter, OrderBy: models.TaskOrder{Field: models.OrderByTitle}})
+		if err != nil {
+			t.Fatalf("ListTasks: %v", err)
+		}
+		var got []string
+		for _, task := range list.Tasks {
+			got = append(got, task.Title)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ListTasks(any %q, all %q) = %q, want %q", tt.filter.LabelsAny, tt.filter.LabelsAll, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+)
+
+// MoveTask gives a task a position next to the requested neighbours, in another
+// project if requested
+func (s *MemoryTaskStore) MoveTask(ctx context.Context, req *models.MoveTaskRequest) (*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.live(req.ID)
+	if !ok {
+		return nil, fmt.Errorf("task with ID %s: %w", req.ID, ErrNotFound)
+	}
+	if req.Version != 0 && existing.Version != req.Version {
+		return nil, fmt.Errorf("task with ID %s is at version %d: %w", req.ID, existing.Version, ErrConflict)
+	}
+
+	project := existing.ProjectID
+	if req.ProjectID != "" {
+		if _, ok := s.projects[req.ProjectID]; !ok {
+			return nil, fmt.Errorf("project with ID %s: %w", req.ProjectID, ErrProjectNotFound)
+		}
+		project = req.ProjectID
+	}
+
+	after, next, err := s.neighbours(req, project)
+	if err != nil {
+		return nil, err
+	}
+	position, err := rank.Between(after, next)
+	if errors.Is(err, rank.ErrOutOfOrder) {
+		// The neighbours share a key, leaving no room between them
+		s.spreadPositions()
+		if after, next, err = s.neighbours(req, project); err != nil {
+			return nil, err
+		}
+		position, err = rank.Between(after, next)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to place task %s: %w", req.ID, err)
+	}
+
+	moved := cloneTask(s.tasks[req.ID])
+	moved.ProjectID, moved.Position = project, position
+	moved.UpdatedAt = time.Now()
+	moved.Version++
+	s.tasks[req.ID] = moved
+	s.record(ctx, models.ChangeUpdated, existing, moved)
+	s.flush()
+	return cloneTask(moved), nil
+}
+
+// RebalancePositions spreads out the manual order once a key is longer than maxLength
+func (s *MemoryTaskStore) RebalancePositions(ctx context.Context, maxLength int) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, task := range s.tasks {
+		if task.DeletedAt == nil && len(task.Position) > maxLength {
+			spread := s.spreadPositions()
+			s.flush()
+			return spread, nil
+		}
+	}
+	return 0, nil
+}
+
+// spreadPositions renumbers the manual order of every project, queueing an event for
+// each task whose key changed, and reports how many did. Callers must hold s.mu and flush.
+func (s *MemoryTaskStore) spreadPositions() int64 {
+	next := make(map[string]int64) // index of the next task in each project
+
+	var spread int64
+	now := time.Now()
+	for _, task := range s.byPosition("", "") {
+		key := rank.Key(next[task.ProjectID])
+		next[task.ProjectID]++
+		if task.Position == key {
+			continue
+		}
+		respaced := cloneTask(task)
+		respaced.Position = key
+		s.tasks[task.ID] = respaced
+		s.queueEvent(models.ChangeUpdated, respaced, now)
+		spread++
+	}
+	return spread
+}
+
+// neighbours returns the positions a task moved per req into project goes between,
+// ignoring the moved task itself; "" stands for either end of the project's list.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) neighbours(req *models.MoveTaskRequest, project string) (string, string, error) {
+	tasks := s.byPosition(req.ID, project)
+	index := func(id string) (int, error) {
+		for i, task := range tasks {
+			if task.ID == id {
+				return i, nil
+			}
+		}
+		if _, ok := s.live(id); ok {
+			return 0, fmt.Errorf("task %s is not in project %s: %w", id, project, ErrInvalid)
+		}
+		return 0, fmt.Errorf("task with ID %s: %w", id, ErrNotFound)
+	}
+	position := func(i int) string {
+		if i < 0 || i >= len(tasks) {
+			return ""
+		}
+		return tasks[i].Position
+	}
+
+	switch {
+	case req.AfterID != "" && req.BeforeID != "":
+		after, err := index(req.AfterID)
+		if err != nil {
+			return "", "", err
+		}
+		next, err := index(req.BeforeID)
+		if err != nil {
+			return "", "", err
+		}
+		if after > next {
+			return "", "", fmt.Errorf("task %s is not listed before task %s: %w", req.AfterID, req.BeforeID, ErrConflict)
+		}
+		return position(after), position(next), nil
+
+	case req.AfterID != "":
+		after, err := index(req.AfterID)
+		if err != nil {
+			return "", "", err
+		}
+		return position(after), position(after + 1), nil
+
+	case req.BeforeID != "":
+		next, err := index(req.BeforeID)
+		if err != nil {
+			return "", "", err
+		}
+		return position(next - 1), position(next), nil
+
+	default:
+		return position(len(tasks) - 1), "", nil
+	}
+}
+
+// byPosition returns the live tasks in project (every project when it is empty) except
+// the one with ID skip, in the manual order. Callers must hold s.mu.
+func (s *MemoryTaskStore) byPosition(skip, project string) []*models.Task {
+	tasks := make([]*models.Task, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		if task.DeletedAt == nil && task.ID != skip && (project == "" || task.ProjectID == project) {
+			tasks = append(tasks, task)
+		}
+	}
+	order := models.TaskOrder{Field: models.OrderByPosition}
+	sort.Slice(tasks, func(i, j int) bool {
+		return order.Less(tasks[i], tasks[j])
+	})
+	return tasks
+}
+
+// lastPosition returns the highest position of a live task in project other than the
+// one with ID skip, or "" when there is none. Callers must hold s.mu.
+func (s *MemoryTaskStore) lastPosition(project, skip string) string {
+	var last string
+	for _, task := range s.tasks {
+		if task.DeletedAt == nil && task.ProjectID == project && task.ID != skip && task.Position > last {
+			last = task.Position
+		}
+	}
+	return last
+}
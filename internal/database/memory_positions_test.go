@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+)
+
+func TestRebalancePositions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+
+	var ids []string
+	for _, title := range []string{"first", "second", "third"} {
+		task := newTestTask(title)
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		ids = append(ids, task.ID)
+	}
+
+	if n, err := store.RebalancePositions(ctx, rank.MaxLength); err != nil || n != 0 {
+		t.Fatalf("RebalancePositions with short keys = %d, %v; want 0", n, err)
+	}
+
+	// Keep moving the last task to just after the first, halving the gap each time
+	for moves := 0; len(longestPosition(t, store, ids)) <= rank.MaxLength; moves++ {
+		if moves > 1000 {
+			t.Fatal("positions never outgrew MaxLength")
+		}
+		last := positionOrder(t, store, ids)[2]
+		if _, err := store.MoveTask(ctx, &models.MoveTaskRequest{ID: last, AfterID: ids[0]}); err != nil {
+			t.Fatalf("MoveTask: %v", err)
+		}
+	}
+	order := positionOrder(t, store, ids)
+
+	n, err := store.RebalancePositions(ctx, rank.MaxLength)
+	if err != nil {
+		t.Fatalf("RebalancePositions: %v", err)
+	}
+	if n != int64(len(ids)) {
+		t.Errorf("RebalancePositions respaced %d tasks, want %d", n, len(ids))
+	}
+	if got := positionOrder(t, store, ids); !slices.Equal(got, order) {
+		t.Errorf("order after rebalancing = %v, want %v", got, order)
+	}
+	if got := longestPosition(t, store, ids); len(got) > 6 {
+		t.Errorf("longest position after rebalancing = %q, want a spread key", got)
+	}
+
+	if n, err := store.RebalancePositions(ctx, rank.MaxLength); err != nil || n != 0 {
+		t.Errorf("second RebalancePositions = %d, %v; want 0", n, err)
+	}
+}
+
+// positionOrder returns ids sorted by their tasks' positions
+func positionOrder(t *testing.T, store *MemoryTaskStore, ids []string) []string {
+	t.Helper()
+	positions := make(map[string]string, len(ids))
+	for _, id := range ids {
+		task, err := store.GetTask(context.Background(), id)
+		if err != nil {
+			t.Fatalf("GetTask: %v", err)
+		}
+		positions[id] = task.Position
+	}
+	sorted := slices.Clone(ids)
+	slices.SortFunc(sorted, func(a, b string) int { return strings.Compare(positions[a], positions[b]) })
+	return sorted
+}
+
+// longestPosition returns the longest position among the tasks
+func longestPosition(t *testing.T, store *MemoryTaskStore, ids []string) string {
+	t.Helper()
+	longest := ""
+	for _, id := range ids {
+		task, err := store.GetTask(context.Background(), id)
+		if err != nil {
+			t.Fatalf("GetTask: %v", err)
+		}
+		if len(task.Position) > len(longest) {
+			longest = task.Position
+		}
+	}
+	return longest
+}
@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+)
+
+// CreateProject adds a new project
+func (s *MemoryTaskStore) CreateProject(ctx context.Context, project *models.Project) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.projects[project.ID]; ok {
+		return fmt.Errorf("failed to create project %s: %w", project.ID, ErrAlreadyExists)
+	}
+	created := *project
+	s.projects[project.ID] = &created
+	return nil
+}
+
+// GetProject retrieves a project by ID
+func (s *MemoryTaskStore) GetProject(ctx context.Context, id string) (*models.Project, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	project, ok := s.projects[id]
+	if !ok {
+		return nil, fmt.Errorf("project with ID %s: %w", id, ErrProjectNotFound)
+	}
+	found := *project
+	return &found, nil
+}
+
+// UpdateProject renames or redescribes a project
+func (s *MemoryTaskStore) UpdateProject(ctx context.Context, req *models.UpdateProjectRequest) (*models.Project, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.projects[req.ID]
+	if !ok {
+		return nil, fmt.Errorf("project with ID %s: %w", req.ID, ErrProjectNotFound)
+	}
+	updated := *existing
+	req.ApplyTo(&updated)
+	updated.UpdatedAt = time.Now()
+	s.projects[req.ID] = &updated
+
+	project := updated
+	return &project, nil
+}
+
+// DeleteProject deletes a project after dealing with its tasks as req says
+func (s *MemoryTaskStore) DeleteProject(ctx context.Context, req *models.DeleteProjectRequest) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if req.ID == models.DefaultProjectID {
+		return fmt.Errorf("cannot delete the default project: %w", ErrInvalid)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.projects[req.ID]; !ok {
+		return fmt.Errorf("project with ID %s: %w", req.ID, ErrProjectNotFound)
+	}
+	tasks := s.byPosition("", req.ID)
+
+	now := time.Now()
+	switch req.Tasks {
+	case models.ProjectDeletionRestrict:
+		if len(tasks) > 0 {
+			return fmt.Errorf("project with ID %s has %d tasks: %w", req.ID, len(tasks), ErrProjectNotEmpty)
+		}
+
+	case models.ProjectDeletionMove:
+		position := s.lastPosition(models.DefaultProjectID, "")
+		for _, existing := range tasks {
+			var err error
+			if position, err = rank.Between(position, ""); err != nil {
+				return err
+			}
+			moved := cloneTask(existing)
+			moved.ProjectID, moved.Position = models.DefaultProjectID, position
+			moved.UpdatedAt = now
+			moved.Version++
+			s.tasks[moved.ID] = moved
+			s.record(ctx, models.ChangeUpdated, existing, moved)
+		}
+
+	case models.ProjectDeletionTrash:
+		for _, existing := range tasks {
+			deleted := cloneTask(existing)
+			deleted.ProjectID = models.DefaultProjectID
+			deleted.DeletedAt = &now
+			deleted.Version++
+			s.tasks[deleted.ID] = deleted
+			s.record(ctx, models.ChangeDeleted, existing, deleted)
+		}
+
+	default:
+		return fmt.Errorf("unknown project deletion mode %d: %w", req.Tasks, ErrInvalid)
+	}
+
+	// Only trashed tasks are left; they move without a new version
+	for id, task := range s.tasks {
+		if task.ProjectID == req.ID {
+			moved := cloneTask(task)
+			moved.ProjectID = models.DefaultProjectID
+			s.tasks[id] = moved
+		}
+	}
+	delete(s.projects, req.ID)
+	s.flush()
+	return nil
+}
+
+// ListProjects lists every project by name
+func (s *MemoryTaskStore) ListProjects(ctx context.Context) ([]*models.Project, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	projects := make([]*models.Project, 0, len(s.projects))
+	for _, project := range s.projects {
+		found := *project
+		projects = append(projects, &found)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(projects, func(i, j int) bool {
+		if projects[i].Name != projects[j].Name {
+			return projects[i].Name < projects[j].Name
+		}
+		return projects[i].ID < projects[j].ID
+	})
+	return projects, nil
+}
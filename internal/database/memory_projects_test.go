@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// newProjectTasks creates a project holding a task for each title, in that order
+func newProjectTasks(t *testing.T, store *MemoryTaskStore, projectID string, titles ...string) []string {
+	t.Helper()
+	ctx := context.Background()
+	if projectID != models.DefaultProjectID {
+		now := time.Now()
+		project := &models.Project{ID: projectID, Name: projectID, CreatedAt: now, UpdatedAt: now}
+		if err := store.CreateProject(ctx, project); err != nil {
+			t.Fatalf("CreateProject: %v", err)
+		}
+	}
+	ids := make([]string, len(titles))
+	for i, title := range titles {
+		task := newTestTask(title)
+		task.ProjectID = projectID
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		ids[i] = task.ID
+	}
+	return ids
+}
+
+func TestProjectCRUD(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	newProjectTasks(t, store, "garden")
+
+	if err := store.CreateProject(ctx, &models.Project{ID: "garden", Name: "again"}); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("creating a project with a taken ID = %v, want ErrAlreadyExists", err)
+	}
+	updated, err := store.UpdateProject(ctx, &models.UpdateProjectRequest{ID: "garden", Name: "Allotment", UpdateMask: []string{models.FieldProjectName}})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	got, err := store.GetProject(ctx, "garden")
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if got.Name != "Allotment" || got.Name != updated.Name {
+		t.Errorf("project is named %q after the rename, want Allotment", got.Name)
+	}
+	if _, err := store.GetProject(ctx, "missing"); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("GetProject of a missing project = %v, want ErrProjectNotFound", err)
+	}
+	if _, err := store.UpdateProject(ctx, &models.UpdateProjectRequest{ID: "missing", Name: "x"}); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("UpdateProject of a missing project = %v, want ErrProjectNotFound", err)
+	}
+
+	projects, err := store.ListProjects(ctx)
+	if err != nil {
+		t.Fatalf("ListProjects: %v", err)
+	}
+	var ids []string
+	for _, project := range projects {
+		ids = append(ids, project.ID)
+	}
+	if !slices.Contains(ids, models.DefaultProjectID) || !slices.Contains(ids, "garden") || len(ids) != 2 {
+		t.Errorf("ListProjects = %q, want the default project and garden", ids)
+	}
+
+	if err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: models.DefaultProjectID}); !errors.Is(err, ErrInvalid) {
+		t.Errorf("deleting the default project = %v, want ErrInvalid", err)
+	}
+	if err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: "garden"}); err != nil {
+		t.Fatalf("DeleteProject of an empty project: %v", err)
+	}
+	if _, err := store.GetProject(ctx, "garden"); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("GetProject after delete = %v, want ErrProjectNotFound", err)
+	}
+	if err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: "garden"}); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("deleting a project twice = %v, want ErrProjectNotFound", err)
+	}
+}
+
+func TestDeleteProjectTasks(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	inbox := newProjectTasks(t, store, models.DefaultProjectID, "inbox")
+	moving := newProjectTasks(t, store, "moving", "first", "second")
+	trashed := newProjectTasks(t, store, "trashed", "doomed")
+
+	// Without a disposition a project with live tasks stays
+	err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: "moving", Tasks: models.ProjectDeletionRestrict})
+	if !errors.Is(err, ErrProjectNotEmpty) {
+		t.Fatalf("DeleteProject with tasks = %v, want ErrProjectNotEmpty", err)
+	}
+	if _, err := store.GetProject(ctx, "moving"); err != nil {
+		t.Errorf("refused DeleteProject removed the project: %v", err)
+	}
+
+	// Moved tasks keep their order at the end of the default project
+	if err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: "moving", Tasks: models.ProjectDeletionMove}); err != nil {
+		t.Fatalf("DeleteProject moving tasks: %v", err)
+	}
+	all := append(slices.Clone(inbox), moving...)
+	if got := positionOrder(t, store, all); !slices.Equal(got, all) {
+		t.Errorf("default project order after the move = %q, want %q", got, all)
+	}
+	for _, id := range moving {
+		task, err := store.GetTask(ctx, id)
+		if err != nil {
+			t.Fatalf("GetTask: %v", err)
+		}
+		if task.ProjectID != models.DefaultProjectID || task.Version != 2 {
+			t.Errorf("moved task is in project %q at version %d, want the default project at version 2",
+				task.ProjectID, task.Version)
+		}
+	}
+
+	// Trashed tasks move to the default project so they can still be restored
+	if err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: "trashed", Tasks: models.ProjectDeletionTrash}); err != nil {
+		t.Fatalf("DeleteProject trashing tasks: %v", err)
+	}
+	if _, err := store.GetTask(ctx, trashed[0]); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTask of a trashed project task = %v, want ErrNotFound", err)
+	}
+	restored, err := store.RestoreTask(ctx, trashed[0], 0)
+	if err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+	if restored.ProjectID != models.DefaultProjectID {
+		t.Errorf("restored task is in project %q, want the default project", restored.ProjectID)
+	}
+
+	// Tasks already in the trash do not keep a project alive
+	leftover := newProjectTasks(t, store, "emptied", "gone")
+	if err := store.DeleteTask(ctx, leftover[0], 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if err := store.DeleteProject(ctx, &models.DeleteProjectRequest{ID: "emptied"}); err != nil {
+		t.Errorf("DeleteProject with only trashed tasks: %v", err)
+	}
+}
+
+func TestMoveTaskBetweenProjects(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	inbox := newProjectTasks(t, store, models.DefaultProjectID, "d1", "d2")
+	work := newProjectTasks(t, store, "work", "w1", "w2", "w3")
+
+	moved, err := store.MoveTask(ctx, &models.MoveTaskRequest{ID: work[1], AfterID: inbox[0], ProjectID: models.DefaultProjectID})
+	if err != nil {
+		t.Fatalf("MoveTask: %v", err)
+	}
+	if moved.ProjectID != models.DefaultProjectID {
+		t.Errorf("moved task is in project %q, want the default project", moved.ProjectID)
+	}
+	if got, want := positionOrder(t, store, []string{inbox[1], work[1], inbox[0]}), []string{inbox[0], work[1], inbox[1]}; !slices.Equal(got, want) {
+		t.Errorf("default project order = %q, want %q", got, want)
+	}
+	if got, want := positionOrder(t, store, []string{work[2], work[0]}), []string{work[0], work[2]}; !slices.Equal(got, want) {
+		t.Errorf("work project order = %q, want %q", got, want)
+	}
+
+	list, err := store.ListTasks(ctx, &models.ListTasksRequest{Filter: models.TaskFilter{ProjectID: "work"}})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 2 {
+		t.Errorf("work project lists %d tasks after the move, want 2", len(list.Tasks))
+	}
+
+	if _, err := store.MoveTask(ctx, &models.MoveTaskRequest{ID: work[0], ProjectID: "missing"}); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("MoveTask to a missing project = %v, want ErrProjectNotFound", err)
+	}
+}
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/google/uuid"
+)
+
+// nextOccurrence returns the task to create after an update from before to after, or
+// nil unless it completes a recurring task whose next occurrence does not exist yet.
+// The new task keeps the parent while that is live, and is prepared so inserting it
+// cannot fail once the update is applied. Callers must hold s.mu.
+func (s *MemoryTaskStore) nextOccurrence(before, after *models.Task) (*models.Task, error) {
+	if !models.CompletesOccurrence(before, after) {
+		return nil, nil
+	}
+	next, err := after.NextOccurrence(uuid.New().String(), after.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("next occurrence of task %s: %w", after.ID, err)
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	for _, task := range s.tasks {
+		if task.SeriesID == next.SeriesID && task.Occurrence == next.Occurrence {
+			return nil, nil
+		}
+	}
+	if _, ok := s.live(next.ParentID); !ok {
+		next.ParentID = ""
+	}
+	if err := s.prepare(next); err != nil {
+		return nil, fmt.Errorf("next occurrence of task %s: %w", after.ID, err)
+	}
+	return next, nil
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// TestFailedNextOccurrenceLeavesTaskUnchanged completes a recurring task whose next
+// occurrence cannot be created, and checks every write path leaves no trace of it
+func TestFailedNextOccurrenceLeavesTaskUnchanged(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		complete func(store *MemoryTaskStore, task *models.Task) error
+	}{
+		{"UpdateTask", func(store *MemoryTaskStore, task *models.Task) error {
+			updated := *task
+			updated.Completed = true
+			return store.UpdateTask(ctx, &updated, []string{models.FieldCompleted}, false)
+		}},
+		{"TransitionTask", func(store *MemoryTaskStore, task *models.Task) error {
+			_, err := store.TransitionTask(ctx, &models.TransitionTaskRequest{ID: task.ID, Status: "done"})
+			return err
+		}},
+		{"BatchUpdateTasks", func(store *MemoryTaskStore, task *models.Task) error {
+			results, err := store.BatchUpdateTasks(ctx, []*models.UpdateTaskRequest{{
+				ID: task.ID, Title: task.Title, Completed: true, Recurrence: task.Recurrence,
+				UpdateMask: []string{models.FieldCompleted},
+			}}, false)
+			if err != nil {
+				return err
+			}
+			return results[0].Err
+		}},
+		{"atomic BatchUpdateTasks", func(store *MemoryTaskStore, task *models.Task) error {
+			_, err := store.BatchUpdateTasks(ctx, []*models.UpdateTaskRequest{{
+				ID: task.ID, Title: task.Title, Completed: true, Recurrence: task.Recurrence,
+				UpdateMask: []string{models.FieldCompleted},
+			}}, true)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryTaskStore(testWorkflow(t))
+			store.projects["doomed"] = &models.Project{ID: "doomed", Name: "Doomed"}
+			task := newTestTask("water the plants")
+			task.ProjectID, task.Recurrence = "doomed", "FREQ=DAILY"
+			if err := store.CreateTask(ctx, task); err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+			seq := store.seq
+
+			// The project goes away underneath the task, so its next occurrence has nowhere to go
+			delete(store.projects, "doomed")
+			if err := tt.complete(store, task); !errors.Is(err, ErrProjectNotFound) {
+				t.Fatalf("completing the task = %v, want ErrProjectNotFound", err)
+			}
+
+			got, err := store.GetTask(ctx, task.ID)
+			if err != nil {
+				t.Fatalf("GetTask: %v", err)
+			}
+			if got.Completed || got.Status != "todo" || got.Version != task.Version {
+				t.Errorf("task after the failed update = completed %t, status %q, version %d; want it unchanged",
+					got.Completed, got.Status, got.Version)
+			}
+			if len(store.tasks) != 1 {
+				t.Errorf("store holds %d tasks, want only the original", len(store.tasks))
+			}
+			if store.seq != seq || len(store.pending) != 0 || len(store.pendingHistory) != 0 || len(store.pendingDeliveries) != 0 {
+				t.Errorf("failed update left changes queued: seq %d -> %d, %d events, %d history entries, %d deliveries",
+					seq, store.seq, len(store.pending), len(store.pendingHistory), len(store.pendingDeliveries))
+			}
+			if n := len(store.history[task.ID]); n != 1 {
+				t.Errorf("task has %d history entries, want only its creation", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+func TestFireReminders(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	now := time.Now()
+
+	remind := func(title string, at time.Duration) *models.Task {
+		t.Helper()
+		task := newTestTask(title)
+		remindAt := now.Add(at)
+		task.RemindAt = &remindAt
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		return task
+	}
+	oldest := remind("oldest", -2*time.Hour)
+	older := remind("older", -time.Hour)
+	remind("future", time.Hour)
+	completed := remind("completed", -3*time.Hour)
+	completed.Completed = true
+	if err := store.UpdateTask(ctx, completed, []string{models.FieldCompleted}, false); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	deleted := remind("deleted", -3*time.Hour)
+	if err := store.DeleteTask(ctx, deleted.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	fire := func(limit int) []*models.Task {
+		t.Helper()
+		fired, err := store.FireReminders(ctx, now, limit)
+		if err != nil {
+			t.Fatalf("FireReminders: %v", err)
+		}
+		return fired
+	}
+
+	// Due reminders fire oldest first; completed, deleted and future tasks are skipped
+	fired := fire(1)
+	if len(fired) != 1 || fired[0].ID != oldest.ID {
+		t.Fatalf("first FireReminders fired %d tasks, want only the oldest reminder", len(fired))
+	}
+	if fired[0].RemindedAt == nil || !fired[0].RemindedAt.Equal(now) || fired[0].Version != oldest.Version {
+		t.Errorf("fired task has reminded_at %v and version %d, want %v and %d",
+			fired[0].RemindedAt, fired[0].Version, now, oldest.Version)
+	}
+	if fired := fire(10); len(fired) != 1 || fired[0].ID != older.ID {
+		t.Fatalf("second FireReminders fired %d tasks, want only the remaining due one", len(fired))
+	}
+
+	// A fired reminder does not fire again, even much later
+	now = now.Add(24 * time.Hour)
+	if fired := fire(10); len(fired) != 1 || fired[0].Title != "future" {
+		t.Errorf("FireReminders a day later fired %d tasks, want only the future one", len(fired))
+	}
+	if fired := fire(10); len(fired) != 0 {
+		t.Errorf("FireReminders fired %d tasks again", len(fired))
+	}
+
+	// Rescheduling the reminder arms it again
+	remindAt := now.Add(-time.Minute)
+	oldest.RemindAt = &remindAt
+	if err := store.UpdateTask(ctx, oldest, []string{models.FieldRemindAt}, false); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if fired := fire(10); len(fired) != 1 || fired[0].ID != oldest.ID {
+		t.Errorf("FireReminders after rescheduling fired %d tasks, want the rescheduled one", len(fired))
+	}
+
+	var reminded int
+	for _, entry := range store.history[oldest.ID] {
+		if entry.Type == models.ChangeReminded {
+			reminded++
+		}
+	}
+	if reminded != 2 {
+		t.Errorf("rescheduled task has %d reminded history entries, want 2", reminded)
+	}
+}
+
+func TestOverdueFilter(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	now := time.Now()
+
+	due := func(title string, at time.Duration) *models.Task {
+		t.Helper()
+		task := newTestTask(title)
+		dueAt := now.Add(at)
+		task.DueAt = &dueAt
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		return task
+	}
+	overdue := due("overdue", -time.Hour)
+	done := due("done late", -time.Hour)
+	done.Completed = true
+	if err := store.UpdateTask(ctx, done, []string{models.FieldCompleted}, false); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	due("due soon", time.Hour)
+	if err := store.CreateTask(ctx, newTestTask("no due date")); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	list, err := store.ListTasks(ctx, &models.ListTasksRequest{Filter: models.TaskFilter{Overdue: true, Now: now}})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 1 || list.Tasks[0].ID != overdue.ID {
+		titles := make([]string, len(list.Tasks))
+		for i, task := range list.Tasks {
+			titles[i] = task.Title
+		}
+		t.Errorf("overdue tasks = %q, want only the incomplete past-due one", titles)
+	}
+
+	// Within two hours everything with a due date is overdue, completed tasks still excepted
+	list, err = store.ListTasks(ctx, &models.ListTasksRequest{Filter: models.TaskFilter{Overdue: true, Now: now.Add(2 * time.Hour)}})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 2 {
+		t.Errorf("two hours later %d tasks are overdue, want 2", len(list.Tasks))
+	}
+	for _, task := range list.Tasks {
+		if task.Completed {
+			t.Errorf("completed task %q listed as overdue", task.Title)
+		}
+	}
+}
@@ -0,0 +1,682 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+	"github.com/Samarth11-A/TaskListAPI/internal/search"
+)
+
+// memoryChangeHistory is how many recent changes MemoryTaskStore keeps for resuming watchers
+const memoryChangeHistory = 10000
+
+// MemoryTaskStore is an in-process TaskStore used for tests and local demos
+type MemoryTaskStore struct {
+	mu    sync.RWMutex
+	tasks map[string]*models.Task
+
+	seq     int64              // seq of the last recorded change
+	pending []models.TaskEvent // changes recorded but not yet published
+	changes *changeFeed
+
+	history        map[string][]*models.TaskHistoryEntry // by task ID, oldest first
+	historySeq     int64                                 // ID of the last history entry
+	pendingHistory []*models.TaskHistoryEntry            // entries recorded but not yet final
+
+	webhooks          map[string]*models.Webhook
+	deliveries        map[int64]*queuedDelivery // queued deliveries by ID
+	deliverySeq       int64                     // ID of the last queued delivery
+	pendingDeliveries []*queuedDelivery         // deliveries recorded but not yet final
+	attempts          []*models.WebhookAttempt  // delivery log, oldest first
+	attemptSeq        int64                     // ID of the last logged attempt
+
+	labels   map[string]*models.Label
+	projects map[string]*models.Project
+
+	workflow *models.Workflow
+}
+
+// NewMemoryTaskStore creates an in-memory task store holding only the default project,
+// whose tasks follow workflow
+func NewMemoryTaskStore(workflow *models.Workflow) *MemoryTaskStore {
+	now := time.Now()
+	return &MemoryTaskStore{
+		tasks:   make(map[string]*models.Task),
+		changes: newChangeFeed(memoryChangeHistory),
+		history: make(map[string][]*models.TaskHistoryEntry),
+
+		webhooks:   make(map[string]*models.Webhook),
+		deliveries: make(map[int64]*queuedDelivery),
+
+		labels: make(map[string]*models.Label),
+		projects: map[string]*models.Project{models.DefaultProjectID: {
+			ID:        models.DefaultProjectID,
+			Name:      models.DefaultProjectName,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}},
+
+		workflow: workflow,
+	}
+}
+
+// CreateTask adds a new task to the store
+func (s *MemoryTaskStore) CreateTask(ctx context.Context, task *models.Task) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.create(ctx, task); err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+// GetTask retrieves a task by ID
+func (s *MemoryTaskStore) GetTask(ctx context.Context, id string) (*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	task, ok := s.live(id)
+	if !ok {
+		return nil, fmt.Errorf("task with ID %s: %w", id, ErrNotFound)
+	}
+	return cloneTask(task), nil
+}
+
+// ListTasks retrieves filtered tasks with keyset pagination in the requested order
+func (s *MemoryTaskStore) ListTasks(ctx context.Context, req *models.ListTasksRequest) (*models.ListTasksResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	order := req.Order()
+
+	s.mu.RLock()
+	tasks := make([]*models.Task, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		if task.DeletedAt != nil || !req.Matches(task) {
+			continue
+		}
+		if req.Cursor != nil && !order.After(task, req.Cursor) {
+			continue
+		}
+		tasks = append(tasks, cloneTask(task))
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return order.Less(tasks[i], tasks[j])
+	})
+	if len(tasks) > int(pageSize)+1 {
+		tasks = tasks[:pageSize+1]
+	}
+
+	return models.NewListTasksResponse(tasks, pageSize), nil
+}
+
+// UpdateTask writes the listed fields of an existing task if its version matches
+func (s *MemoryTaskStore) UpdateTask(ctx context.Context, task *models.Task, fields []string, ignoreBlockers bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.live(task.ID)
+	if !ok {
+		return fmt.Errorf("task with ID %s: %w", task.ID, ErrNotFound)
+	}
+	if existing.Version != task.Version {
+		return fmt.Errorf("task with ID %s is at version %d: %w", task.ID, existing.Version, ErrConflict)
+	}
+
+	s.workflow.Settle(existing, task)
+	if err := checkBlocked(existing, task, fields, ignoreBlockers); err != nil {
+		return err
+	}
+	if updatesParent(fields) && task.ParentID != existing.ParentID {
+		if err := s.checkParent(task.ID, task.ParentID); err != nil {
+			return err
+		}
+	}
+	if fields == nil {
+		fields = models.UpdatableFields
+	}
+
+	updated := cloneTask(existing)
+	for _, field := range fields {
+		switch field {
+		case models.FieldTitle:
+			updated.Title = task.Title
+		case models.FieldDescription:
+			updated.Description = task.Description
+		case models.FieldCompleted, models.FieldStatus:
+			updated.Completed, updated.Status, updated.CompletedAt = task.Completed, task.Status, task.CompletedAt
+		case models.FieldDueAt:
+			updated.DueAt = task.DueAt
+		case models.FieldRemindAt:
+			updated.RemindAt, updated.RemindedAt = task.RemindAt, nil
+		case models.FieldPriority:
+			updated.Priority = task.Priority
+		case models.FieldParentID:
+			updated.ParentID = task.ParentID
+		case models.FieldRecurrence:
+			updated.Recurrence, updated.SeriesID, updated.Occurrence = task.Recurrence, task.SeriesID, task.Occurrence
+		default:
+			return fmt.Errorf("cannot update unknown field %q: %w", field, ErrInvalid)
+		}
+	}
+	updated.UpdatedAt = task.UpdatedAt
+	updated.Version++
+	updated = cloneTask(updated) // detach from the caller's time pointers
+	next, err := s.nextOccurrence(existing, updated)
+	if err != nil {
+		return err
+	}
+	s.tasks[task.ID] = updated
+	s.record(ctx, models.ChangeUpdated, existing, updated)
+	if next != nil {
+		s.insert(ctx, next)
+	}
+	s.flush()
+
+	task.Version = updated.Version
+	return nil
+}
+
+// DeleteTask moves a task to the trash if its version matches
+func (s *MemoryTaskStore) DeleteTask(ctx context.Context, id string, version int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.delete(ctx, id, version); err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+// RestoreTask moves a task out of the trash if its version matches
+func (s *MemoryTaskStore) RestoreTask(ctx context.Context, id string, version int64) (*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.tasks[id]
+	if !ok || existing.DeletedAt == nil {
+		return nil, fmt.Errorf("deleted task with ID %s: %w", id, ErrNotFound)
+	}
+	if version != 0 && existing.Version != version {
+		return nil, fmt.Errorf("task with ID %s is at version %d: %w", id, existing.Version, ErrConflict)
+	}
+
+	restored := cloneTask(existing)
+	restored.DeletedAt = nil
+	restored.Version++
+	s.tasks[id] = restored
+	s.record(ctx, models.ChangeRestored, existing, restored)
+	s.flush()
+	return cloneTask(restored), nil
+}
+
+// ListDeletedTasks lists trashed tasks, most recently deleted first
+func (s *MemoryTaskStore) ListDeletedTasks(ctx context.Context, req *models.ListDeletedTasksRequest) (*models.ListDeletedTasksResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	s.mu.RLock()
+	var tasks []*models.Task
+	for _, task := range s.tasks {
+		if task.DeletedAt == nil || (req.Cursor != nil && !req.Cursor.DeletedBefore(task)) {
+			continue
+		}
+		tasks = append(tasks, cloneTask(task))
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool {
+		cursor := &models.TrashCursor{DeletedAt: *tasks[i].DeletedAt, ID: tasks[i].ID}
+		return cursor.DeletedBefore(tasks[j])
+	})
+	if len(tasks) > int(pageSize)+1 {
+		tasks = tasks[:pageSize+1]
+	}
+
+	return models.NewListDeletedTasksResponse(tasks, pageSize), nil
+}
+
+// PurgeDeletedTasks permanently removes tasks deleted before cutoff; their subtasks
+// become top-level tasks
+func (s *MemoryTaskStore) PurgeDeletedTasks(ctx context.Context, cutoff time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int64
+	now := time.Now()
+	for id, task := range s.tasks {
+		if task.DeletedAt != nil && task.DeletedAt.Before(cutoff) {
+			delete(s.tasks, id)
+			entry := &models.TaskHistoryEntry{
+				TaskID:     id,
+				Type:       models.ChangePurged,
+				Actor:      audit.ActorFrom(ctx),
+				RequestID:  audit.RequestIDFrom(ctx),
+				Changes:    []models.FieldChange{},
+				Version:    task.Version,
+				OccurredAt: now,
+			}
+			s.appendHistory(entry)
+			s.queueDeliveries([]string{models.EventTaskPurged}, entry, nil)
+			purged++
+		}
+	}
+	// Like the foreign keys in PostgreSQL, orphans and the tasks purged tasks blocked
+	// change without a new version
+	for id, task := range s.tasks {
+		if _, ok := s.tasks[task.ParentID]; task.ParentID != "" && !ok {
+			orphan := cloneTask(task)
+			orphan.ParentID = ""
+			s.tasks[id] = orphan
+			s.queueEvent(models.ChangeUpdated, orphan, now)
+		}
+	}
+	s.dropPurgedBlockers()
+	s.flush()
+	return purged, nil
+}
+
+// FireReminders marks up to limit due reminders as fired, oldest reminder first
+func (s *MemoryTaskStore) FireReminders(ctx context.Context, now time.Time, limit int) ([]*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*models.Task
+	for _, task := range s.tasks {
+		if task.ReminderDue(now) {
+			due = append(due, task)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].RemindAt.Equal(*due[j].RemindAt) {
+			return due[i].RemindAt.Before(*due[j].RemindAt)
+		}
+		return due[i].ID < due[j].ID
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	fired := make([]*models.Task, len(due))
+	for i, existing := range due {
+		reminded := cloneTask(existing)
+		reminded.RemindedAt = &now
+		s.tasks[existing.ID] = reminded
+		s.record(ctx, models.ChangeReminded, existing, reminded)
+		fired[i] = cloneTask(reminded)
+	}
+	s.flush()
+	return fired, nil
+}
+
+// SearchTasks ranks tasks with a simple tokenizer-based match over title and description
+func (s *MemoryTaskStore) SearchTasks(ctx context.Context, req *models.SearchTasksRequest) (*models.SearchTasksResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	pageSize := models.NormalizePageSize(req.PageSize)
+	query := search.ParseQuery(req.Query)
+
+	s.mu.RLock()
+	var results []*models.SearchResult
+	for _, task := range s.tasks {
+		if task.DeletedAt != nil {
+			continue
+		}
+		rank := query.Rank(task.Title, task.Description)
+		if rank == 0 {
+			continue
+		}
+
+		result := &models.SearchResult{Task: cloneTask(task), Rank: rank}
+		if req.Cursor != nil && (result.Before(req.Cursor) || task.ID == req.Cursor.ID) {
+			continue
+		}
+		results = append(results, result)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Before(&models.SearchCursor{Rank: results[j].Rank, ID: results[j].Task.ID})
+	})
+	if len(results) > int(pageSize)+1 {
+		results = results[:pageSize+1]
+	}
+
+	// Only build snippets for the results actually returned
+	for _, result := range results {
+		result.TitleSnippet = query.Snippet(result.Task.Title)
+		result.DescriptionSnippet = query.Snippet(result.Task.Description)
+	}
+
+	return models.NewSearchTasksResponse(results, pageSize), nil
+}
+
+// WatchTasks streams changes from the in-process feed, resuming from its recent history
+func (s *MemoryTaskStore) WatchTasks(ctx context.Context, seq int64, fn func(models.TaskEvent) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.changes.watch(ctx, seq, s.changes.since, fn)
+}
+
+// PruneTaskChanges drops retained changes recorded before cutoff
+func (s *MemoryTaskStore) PruneTaskChanges(ctx context.Context, cutoff time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return s.changes.prune(cutoff), nil
+}
+
+// ListTaskHistory lists a task's history entries, newest first
+func (s *MemoryTaskStore) ListTaskHistory(ctx context.Context, req *models.ListTaskHistoryRequest) (*models.ListTaskHistoryResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	s.mu.RLock()
+	history := s.history[req.TaskID]
+	var entries []*models.TaskHistoryEntry
+	for i := len(history) - 1; i >= 0 && len(entries) <= int(pageSize); i-- {
+		if req.Cursor != nil && history[i].ID >= req.Cursor.ID {
+			continue
+		}
+		entry := *history[i]
+		entries = append(entries, &entry)
+	}
+	s.mu.RUnlock()
+
+	return models.NewListTaskHistoryResponse(entries, pageSize), nil
+}
+
+// BatchCreateTasks adds every task in one critical section
+func (s *MemoryTaskStore) BatchCreateTasks(ctx context.Context, tasks []*models.Task, atomic bool) ([]models.BatchResult, error) {
+	return s.batch(ctx, len(tasks), atomic, func(i int) (*models.Task, error) {
+		if err := s.create(ctx, tasks[i]); err != nil {
+			return nil, err
+		}
+		return cloneTask(tasks[i]), nil
+	})
+}
+
+// BatchUpdateTasks applies every update request in one critical section
+func (s *MemoryTaskStore) BatchUpdateTasks(ctx context.Context, reqs []*models.UpdateTaskRequest, atomic bool) ([]models.BatchResult, error) {
+	now := time.Now()
+	return s.batch(ctx, len(reqs), atomic, func(i int) (*models.Task, error) {
+		req := reqs[i]
+		existing, ok := s.live(req.ID)
+		if !ok {
+			return nil, fmt.Errorf("task with ID %s: %w", req.ID, ErrNotFound)
+		}
+		if req.Version != 0 && existing.Version != req.Version {
+			return nil, fmt.Errorf("task with ID %s is at version %d: %w", req.ID, existing.Version, ErrConflict)
+		}
+
+		updated := cloneTask(existing)
+		req.ApplyTo(updated)
+		updated.UpdatedAt = now
+		s.workflow.Settle(existing, updated)
+		if err := checkBlocked(existing, updated, req.Fields(), req.IgnoreBlockers); err != nil {
+			return nil, err
+		}
+		if updatesParent(req.Fields()) && updated.ParentID != existing.ParentID {
+			if err := s.checkParent(req.ID, updated.ParentID); err != nil {
+				return nil, err
+			}
+		}
+		updated.Version++
+		updated = cloneTask(updated) // detach from the request's time pointers
+		next, err := s.nextOccurrence(existing, updated)
+		if err != nil {
+			return nil, err
+		}
+		s.tasks[req.ID] = updated
+		s.record(ctx, models.ChangeUpdated, existing, updated)
+		if next != nil {
+			s.insert(ctx, next)
+		}
+		return cloneTask(updated), nil
+	})
+}
+
+// BatchDeleteTasks moves every requested task to the trash in one critical section
+func (s *MemoryTaskStore) BatchDeleteTasks(ctx context.Context, reqs []*models.DeleteTaskRequest, atomic bool) ([]models.BatchResult, error) {
+	return s.batch(ctx, len(reqs), atomic, func(i int) (*models.Task, error) {
+		return nil, s.delete(ctx, reqs[i].ID, reqs[i].Version)
+	})
+}
+
+// batch runs item for indexes 0..n-1 under the write lock. In atomic mode the first
+// error restores the map as it was before the batch. Items must replace tasks rather
+// than modify them in place so the shallow snapshot stays valid.
+func (s *MemoryTaskStore) batch(ctx context.Context, n int, atomic bool, item func(i int) (*models.Task, error)) ([]models.BatchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var snapshot map[string]*models.Task
+	if atomic {
+		snapshot = maps.Clone(s.tasks)
+	}
+	seq := s.seq
+
+	results := make([]models.BatchResult, n)
+	for i := 0; i < n; i++ {
+		task, err := item(i)
+		if err != nil && atomic {
+			s.tasks = snapshot
+			s.seq, s.pending, s.pendingHistory = seq, s.pending[:0], s.pendingHistory[:0]
+			s.pendingDeliveries = s.pendingDeliveries[:0]
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		results[i] = models.BatchResult{Task: task, Err: err}
+	}
+	s.flush()
+	return results, nil
+}
+
+// create adds a new task. Callers must hold s.mu.
+func (s *MemoryTaskStore) create(ctx context.Context, task *models.Task) error {
+	if err := s.prepare(task); err != nil {
+		return err
+	}
+	s.insert(ctx, task)
+	return nil
+}
+
+// prepare checks that task can be created and fills in its defaults without changing
+// the store, so callers can validate every task a write creates before applying any of
+// it. Callers must hold s.mu.
+func (s *MemoryTaskStore) prepare(task *models.Task) error {
+	if _, ok := s.tasks[task.ID]; ok {
+		return fmt.Errorf("failed to create task %s: %w", task.ID, ErrAlreadyExists)
+	}
+	if task.ParentID != "" {
+		parent, ok := s.live(task.ParentID)
+		if !ok {
+			return fmt.Errorf("task with ID %s: %w", task.ParentID, ErrParentNotFound)
+		}
+		if task.ProjectID == "" {
+			task.ProjectID = parent.ProjectID
+		}
+	}
+	if task.ProjectID == "" {
+		task.ProjectID = models.DefaultProjectID
+	}
+	if _, ok := s.projects[task.ProjectID]; !ok {
+		return fmt.Errorf("project with ID %s: %w", task.ProjectID, ErrProjectNotFound)
+	}
+	if task.Version == 0 {
+		task.Version = 1
+	}
+	task.StartSeries()
+	s.workflow.Settle(nil, task)
+	if task.Position == "" {
+		position, err := rank.Between(s.lastPosition(task.ProjectID, ""), "")
+		if err != nil {
+			return err
+		}
+		task.Position = position
+	}
+	task.Labels = nil // labels are only attached through AddTaskLabels
+	task.BlockedBy, task.Blocked = nil, false
+	return nil
+}
+
+// insert adds a task that prepare accepted. Callers must hold s.mu.
+func (s *MemoryTaskStore) insert(ctx context.Context, task *models.Task) {
+	s.tasks[task.ID] = cloneTask(task)
+	s.record(ctx, models.ChangeCreated, nil, task)
+}
+
+// delete moves a task to the trash if its version matches. Callers must hold s.mu.
+func (s *MemoryTaskStore) delete(ctx context.Context, id string, version int64) error {
+	existing, ok := s.live(id)
+	if !ok {
+		return fmt.Errorf("task with ID %s: %w", id, ErrNotFound)
+	}
+	if version != 0 && existing.Version != version {
+		return fmt.Errorf("task with ID %s is at version %d: %w", id, existing.Version, ErrConflict)
+	}
+
+	deleted := cloneTask(existing)
+	now := time.Now()
+	deleted.DeletedAt = &now
+	deleted.Version++
+	s.tasks[id] = deleted
+	s.record(ctx, models.ChangeDeleted, existing, deleted)
+	return nil
+}
+
+// record queues a change from before to after for publishing, for the task's history
+// and for webhooks, and updates the tasks it blocks; before is nil for creates.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) record(ctx context.Context, kind string, before, after *models.Task) {
+	if before != nil && (before.Completed != after.Completed || (before.DeletedAt == nil) != (after.DeletedAt == nil)) {
+		s.refreshBlocked(after.ID)
+	}
+
+	now := time.Now()
+	s.queueEvent(kind, after, now)
+
+	entry := models.NewTaskHistoryEntry(kind, audit.ActorFrom(ctx), audit.RequestIDFrom(ctx), before, after)
+	entry.OccurredAt = now
+	s.pendingHistory = append(s.pendingHistory, entry)
+	s.queueDeliveries(models.EventTypes(kind, before, after), entry, after)
+}
+
+// queueEvent queues a change for publishing to watchers only. Callers must hold s.mu.
+func (s *MemoryTaskStore) queueEvent(kind string, task *models.Task, now time.Time) {
+	s.seq++
+	s.pending = append(s.pending, models.TaskEvent{
+		Seq:        s.seq,
+		Type:       kind,
+		Task:       cloneTask(task),
+		OccurredAt: now,
+	})
+}
+
+// flush publishes the queued changes and appends their history once they are final.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) flush() {
+	for _, ev := range s.pending {
+		s.changes.publish(ev)
+	}
+	s.pending = s.pending[:0]
+
+	for _, entry := range s.pendingHistory {
+		s.appendHistory(entry)
+	}
+	s.pendingHistory = s.pendingHistory[:0]
+
+	for _, d := range s.pendingDeliveries {
+		s.deliverySeq++
+		d.ID = s.deliverySeq
+		s.deliveries[d.ID] = d
+	}
+	s.pendingDeliveries = s.pendingDeliveries[:0]
+}
+
+// appendHistory assigns entry the next ID and stores it. Callers must hold s.mu.
+func (s *MemoryTaskStore) appendHistory(entry *models.TaskHistoryEntry) {
+	s.historySeq++
+	entry.ID = s.historySeq
+	s.history[entry.TaskID] = append(s.history[entry.TaskID], entry)
+}
+
+// live returns the task with the given ID unless it is missing or in the trash.
+// Callers must hold s.mu.
+func (s *MemoryTaskStore) live(id string) (*models.Task, bool) {
+	task, ok := s.tasks[id]
+	if !ok || task.DeletedAt != nil {
+		return nil, false
+	}
+	return task, true
+}
+
+// cloneTask returns a copy so callers never share memory with the store
+func cloneTask(task *models.Task) *models.Task {
+	clone := *task
+	for _, t := range []**time.Time{&clone.CompletedAt, &clone.DeletedAt, &clone.DueAt, &clone.RemindAt, &clone.RemindedAt} {
+		if *t != nil {
+			copied := **t
+			*t = &copied
+		}
+	}
+	clone.Labels = slices.Clone(clone.Labels)
+	clone.BlockedBy = slices.Clone(clone.BlockedBy)
+	return &clone
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// GetTaskTree returns a live task with its live subtasks nested req.Depth levels deep
+func (s *MemoryTaskStore) GetTaskTree(ctx context.Context, req *models.GetTaskTreeRequest) (*models.TaskNode, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	root, ok := s.live(req.ID)
+	if !ok {
+		return nil, fmt.Errorf("task with ID %s: %w", req.ID, ErrNotFound)
+	}
+	children := make(map[string][]*models.Task)
+	for _, task := range s.tasks {
+		if task.DeletedAt == nil && task.ParentID != "" {
+			children[task.ParentID] = append(children[task.ParentID], task)
+		}
+	}
+
+	// Every level is walked for the counts; only nodes down to the depth are kept
+	var nodes []*models.TaskNode
+	var walk func(task *models.Task, depth int) *models.TaskNode
+	walk = func(task *models.Task, depth int) *models.TaskNode {
+		node := &models.TaskNode{Task: cloneTask(task)}
+		for _, child := range children[task.ID] {
+			sub := walk(child, depth+1)
+			node.SubtaskCount += 1 + sub.SubtaskCount
+			node.CompletedSubtasks += sub.CompletedSubtasks
+			if child.Completed {
+				node.CompletedSubtasks++
+			}
+		}
+		if depth <= req.TreeDepth() {
+			nodes = append(nodes, node)
+		}
+		return node
+	}
+	walk(root, 0)
+	return models.NewTaskTree(req.ID, nodes), nil
+}
+
+// checkParent checks that task id may become a subtask of parentID: the parent must be
+// live, and neither id itself nor one of its subtasks. Callers must hold s.mu.
+func (s *MemoryTaskStore) checkParent(id, parentID string) error {
+	if parentID == "" {
+		return nil
+	}
+	if _, ok := s.live(parentID); !ok {
+		return fmt.Errorf("task with ID %s: %w", parentID, ErrParentNotFound)
+	}
+
+	// Trashed ancestors still count: restoring them must not complete a cycle
+	for ancestor := parentID; ancestor != ""; {
+		if ancestor == id {
+			return fmt.Errorf("task with ID %s is a subtask of task %s: %w", parentID, id, ErrTaskCycle)
+		}
+		task, ok := s.tasks[ancestor]
+		if !ok {
+			break
+		}
+		ancestor = task.ParentID
+	}
+	return nil
+}
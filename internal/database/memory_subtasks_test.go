@@ -0,0 +1,165 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// newSubtask creates a task with title under parentID, or at the top level when it is empty
+func newSubtask(t *testing.T, store *MemoryTaskStore, parentID, title string) *models.Task {
+	t.Helper()
+	task := newTestTask(title)
+	task.ParentID = parentID
+	if err := store.CreateTask(context.Background(), task); err != nil {
+		t.Fatalf("CreateTask(%q): %v", title, err)
+	}
+	return task
+}
+
+// subtaskTitles returns the titles of node's subtasks in order
+func subtaskTitles(node *models.TaskNode) []string {
+	titles := []string{}
+	for _, sub := range node.Subtasks {
+		titles = append(titles, sub.Task.Title)
+	}
+	return titles
+}
+
+func TestGetTaskTree(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	root := newSubtask(t, store, "", "root")
+	a := newSubtask(t, store, root.ID, "a")
+	newSubtask(t, store, root.ID, "b")
+	a1 := newSubtask(t, store, a.ID, "a1")
+	a2 := newSubtask(t, store, a.ID, "a2")
+	newSubtask(t, store, a1.ID, "a11")
+	trashed := newSubtask(t, store, root.ID, "trashed")
+	newSubtask(t, store, trashed.ID, "under trashed")
+	if err := store.DeleteTask(ctx, trashed.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	a2.Completed = true
+	if err := store.UpdateTask(ctx, a2, []string{models.FieldCompleted}, false); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	tree, err := store.GetTaskTree(ctx, &models.GetTaskTreeRequest{ID: root.ID, Depth: 1})
+	if err != nil {
+		t.Fatalf("GetTaskTree: %v", err)
+	}
+	if got := subtaskTitles(tree); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("root's subtasks = %q, want a and b", got)
+	}
+	if len(tree.Subtasks[0].Subtasks) != 0 {
+		t.Errorf("depth 1 tree nests %d tasks under a, want none", len(tree.Subtasks[0].Subtasks))
+	}
+
+	// Counts cover every live level even below the depth; trashed branches are left out
+	if tree.SubtaskCount != 5 || tree.CompletedSubtasks != 1 || tree.Progress() != 20 {
+		t.Errorf("root counts %d subtasks, %d completed, progress %d; want 5, 1, 20",
+			tree.SubtaskCount, tree.CompletedSubtasks, tree.Progress())
+	}
+	if node := tree.Subtasks[0]; node.SubtaskCount != 3 || node.CompletedSubtasks != 1 {
+		t.Errorf("a counts %d subtasks, %d completed; want 3, 1", node.SubtaskCount, node.CompletedSubtasks)
+	}
+
+	tree, err = store.GetTaskTree(ctx, &models.GetTaskTreeRequest{ID: root.ID})
+	if err != nil {
+		t.Fatalf("GetTaskTree: %v", err)
+	}
+	node := tree.Subtasks[0]
+	if got := subtaskTitles(node); len(got) != 2 || got[0] != "a1" || got[1] != "a2" {
+		t.Fatalf("a's subtasks = %q, want a1 and a2", got)
+	}
+	if got := subtaskTitles(node.Subtasks[0]); len(got) != 1 || got[0] != "a11" {
+		t.Errorf("a1's subtasks = %q, want a11", got)
+	}
+	if node.Subtasks[1].Progress() != 100 {
+		t.Errorf("completed leaf has progress %d, want 100", node.Subtasks[1].Progress())
+	}
+
+	if _, err := store.GetTaskTree(ctx, &models.GetTaskTreeRequest{ID: trashed.ID}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTaskTree of a trashed task = %v, want ErrNotFound", err)
+	}
+}
+
+func TestReparentTask(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	root := newSubtask(t, store, "", "root")
+	a := newSubtask(t, store, root.ID, "a")
+	b := newSubtask(t, store, root.ID, "b")
+	a1 := newSubtask(t, store, a.ID, "a1")
+	a11 := newSubtask(t, store, a1.ID, "a11")
+	trashed := newSubtask(t, store, "", "trashed")
+	if err := store.DeleteTask(ctx, trashed.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	reparent := func(task *models.Task, parentID string) error {
+		moved := *task
+		moved.ParentID = parentID
+		err := store.UpdateTask(ctx, &moved, []string{models.FieldParentID}, false)
+		if err == nil {
+			*task = moved
+		}
+		return err
+	}
+
+	// Moving a subtask takes its own subtasks along
+	if err := reparent(a1, b.ID); err != nil {
+		t.Fatalf("moving a1 under b: %v", err)
+	}
+	tree, err := store.GetTaskTree(ctx, &models.GetTaskTreeRequest{ID: root.ID})
+	if err != nil {
+		t.Fatalf("GetTaskTree: %v", err)
+	}
+	nodeA, nodeB := tree.Subtasks[0], tree.Subtasks[1]
+	if nodeA.SubtaskCount != 0 || nodeB.SubtaskCount != 2 || len(nodeB.Subtasks) != 1 || len(nodeB.Subtasks[0].Subtasks) != 1 {
+		t.Errorf("after the move a has %d subtasks and b %d, want 0 and 2 nested b > a1 > a11",
+			nodeA.SubtaskCount, nodeB.SubtaskCount)
+	}
+
+	tests := []struct {
+		name     string
+		task     *models.Task
+		parentID string
+		want     error
+	}{
+		{"own parent", b, b.ID, ErrTaskCycle},
+		{"under own subtask", root, a11.ID, ErrTaskCycle},
+		{"under own grandchild", b, a11.ID, ErrTaskCycle},
+		{"trashed parent", a, trashed.ID, ErrParentNotFound},
+		{"missing parent", a, "missing", ErrParentNotFound},
+	}
+	for _, tt := range tests {
+		if err := reparent(tt.task, tt.parentID); !errors.Is(err, tt.want) {
+			t.Errorf("%s: UpdateTask = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+	task := newTestTask("under trashed")
+	task.ParentID = trashed.ID
+	if err := store.CreateTask(ctx, task); !errors.Is(err, ErrParentNotFound) {
+		t.Errorf("CreateTask under a trashed parent = %v, want ErrParentNotFound", err)
+	}
+
+	// A trashed ancestor still blocks a cycle, so restoring it cannot complete one
+	if err := store.DeleteTask(ctx, a1.ID, 0); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if err := reparent(b, a11.ID); !errors.Is(err, ErrTaskCycle) {
+		t.Errorf("moving b under a11 through trashed a1 = %v, want ErrTaskCycle", err)
+	}
+
+	// Clearing the parent makes a top-level task
+	if err := reparent(a11, ""); err != nil {
+		t.Fatalf("moving a11 to the top level: %v", err)
+	}
+	if got, err := store.GetTask(ctx, a11.ID); err != nil || got.ParentID != "" {
+		t.Errorf("GetTask after clearing the parent = %v, %v; want no parent", got, err)
+	}
+}
@@ -0,0 +1,191 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+func TestSoftDeleteAndRestore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	task, other := newTestTask("file taxes"), newTestTask("walk the dog")
+	for _, task := range []*models.Task{task, other} {
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+
+	if err := store.DeleteTask(ctx, task.ID, task.Version); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	// A trashed task is hidden from every read of live tasks
+	if _, err := store.GetTask(ctx, task.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTask of a trashed task = %v, want ErrNotFound", err)
+	}
+	list, err := store.ListTasks(ctx, &models.ListTasksRequest{})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 1 || list.Tasks[0].ID != other.ID {
+		t.Errorf("ListTasks lists %d tasks, want only the live one", len(list.Tasks))
+	}
+	if err := store.DeleteTask(ctx, task.ID, 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("deleting a trashed task again = %v, want ErrNotFound", err)
+	}
+	if _, err := store.RestoreTask(ctx, other.ID, 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("restoring a live task = %v, want ErrNotFound", err)
+	}
+
+	// Deleting bumped the version, so the version the client last saw is stale
+	if _, err := store.RestoreTask(ctx, task.ID, task.Version); !errors.Is(err, ErrConflict) {
+		t.Errorf("RestoreTask with a stale version = %v, want ErrConflict", err)
+	}
+	restored, err := store.RestoreTask(ctx, task.ID, task.Version+1)
+	if err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+	if restored.DeletedAt != nil || restored.Version != task.Version+2 {
+		t.Errorf("restored task has deleted_at %v and version %d, want nil and %d",
+			restored.DeletedAt, restored.Version, task.Version+2)
+	}
+
+	got, err := store.GetTask(ctx, task.ID)
+	if err != nil {
+		t.Fatalf("GetTask after restore: %v", err)
+	}
+	if got.Title != task.Title || got.Version != restored.Version {
+		t.Errorf("GetTask after restore = %q at version %d, want %q at version %d",
+			got.Title, got.Version, task.Title, restored.Version)
+	}
+	list, err = store.ListTasks(ctx, &models.ListTasksRequest{})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 2 {
+		t.Errorf("ListTasks after restore lists %d tasks, want 2", len(list.Tasks))
+	}
+	trash, err := store.ListDeletedTasks(ctx, &models.ListDeletedTasksRequest{})
+	if err != nil {
+		t.Fatalf("ListDeletedTasks: %v", err)
+	}
+	if len(trash.Tasks) != 0 {
+		t.Errorf("trash holds %d tasks after restore, want none", len(trash.Tasks))
+	}
+}
+
+func TestListDeletedTasksPaging(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+
+	// Two tasks share a deletion time so paging has to fall back to the ID
+	base := time.Now().Add(-time.Hour)
+	offsets := []time.Duration{0, 3 * time.Minute, time.Minute, time.Minute, 2 * time.Minute}
+	for _, offset := range offsets {
+		task := newTestTask("trash me")
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		if err := store.DeleteTask(ctx, task.ID, 0); err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+		deletedAt := base.Add(offset)
+		store.tasks[task.ID].DeletedAt = &deletedAt
+	}
+	if err := store.CreateTask(ctx, newTestTask("keep me")); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	var got []*models.Task
+	req := &models.ListDeletedTasksRequest{PageSize: 2}
+	for pages := 0; ; pages++ {
+		if pages > len(offsets) {
+			t.Fatal("paging does not terminate")
+		}
+		resp, err := store.ListDeletedTasks(ctx, req)
+		if err != nil {
+			t.Fatalf("ListDeletedTasks: %v", err)
+		}
+		if len(resp.Tasks) > 2 {
+			t.Fatalf("page has %d tasks, want at most 2", len(resp.Tasks))
+		}
+		got = append(got, resp.Tasks...)
+		if resp.NextCursor == nil {
+			break
+		}
+		req.Cursor = resp.NextCursor
+	}
+
+	if len(got) != len(offsets) {
+		t.Fatalf("listed %d trashed tasks, want %d", len(got), len(offsets))
+	}
+	seen := map[string]bool{}
+	for i, task := range got {
+		if seen[task.ID] {
+			t.Errorf("task %s listed twice", task.ID)
+		}
+		seen[task.ID] = true
+		if i > 0 && task.DeletedAt.After(*got[i-1].DeletedAt) {
+			t.Errorf("task %d was deleted at %v, after task %d at %v; want newest first",
+				i, task.DeletedAt, i-1, got[i-1].DeletedAt)
+		}
+	}
+}
+
+func TestPurgeDeletedTasks(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTaskStore(testWorkflow(t))
+	retention := 24 * time.Hour
+
+	old, recent, live := newTestTask("old"), newTestTask("recent"), newTestTask("live")
+	for _, task := range []*models.Task{old, recent, live} {
+		if err := store.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+	child := newTestTask("child of old")
+	child.ParentID = old.ID
+	if err := store.CreateTask(ctx, child); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	for task, age := range map[*models.Task]time.Duration{old: 2 * retention, recent: retention / 2} {
+		if err := store.DeleteTask(ctx, task.ID, 0); err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+		deletedAt := time.Now().Add(-age)
+		store.tasks[task.ID].DeletedAt = &deletedAt
+	}
+
+	purged, err := store.PurgeDeletedTasks(ctx, time.Now().Add(-retention))
+	if err != nil {
+		t.Fatalf("PurgeDeletedTasks: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("purged %d tasks, want 1", purged)
+	}
+	if _, ok := store.tasks[old.ID]; ok {
+		t.Error("task deleted before the cutoff was not purged")
+	}
+	if task, ok := store.tasks[recent.ID]; !ok || task.DeletedAt == nil {
+		t.Error("task deleted after the cutoff was purged or restored")
+	}
+	if _, err := store.GetTask(ctx, live.ID); err != nil {
+		t.Errorf("GetTask of a live task after purge: %v", err)
+	}
+	orphan, err := store.GetTask(ctx, child.ID)
+	if err != nil {
+		t.Fatalf("GetTask of the purged task's subtask: %v", err)
+	}
+	if orphan.ParentID != "" {
+		t.Errorf("subtask of a purged task still has parent %q", orphan.ParentID)
+	}
+
+	// Nothing else is old enough
+	if purged, err := store.PurgeDeletedTasks(ctx, time.Now().Add(-retention)); err != nil || purged != 0 {
+		t.Errorf("second purge = %d, %v; want 0, nil", purged, err)
+	}
+}
@@ -0,0 +1,262 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// queuedDelivery is a delivery waiting in MemoryTaskStore's queue
+type queuedDelivery struct {
+	models.WebhookDelivery
+	webhookID     string
+	nextAttemptAt time.Time
+}
+
+// CreateWebhook adds a new webhook
+func (s *MemoryTaskStore) CreateWebhook(ctx context.Context, hook *models.Webhook) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhooks[hook.ID]; ok {
+		return fmt.Errorf("failed to create webhook %s: %w", hook.ID, ErrAlreadyExists)
+	}
+	s.webhooks[hook.ID] = cloneWebhook(hook)
+	return nil
+}
+
+// ListWebhooks lists every webhook, oldest first
+func (s *MemoryTaskStore) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	hooks := make([]*models.Webhook, 0, len(s.webhooks))
+	for _, hook := range s.webhooks {
+		hooks = append(hooks, cloneWebhook(hook))
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(hooks, func(i, j int) bool {
+		if !hooks[i].CreatedAt.Equal(hooks[j].CreatedAt) {
+			return hooks[i].CreatedAt.Before(hooks[j].CreatedAt)
+		}
+		return hooks[i].ID < hooks[j].ID
+	})
+	return hooks, nil
+}
+
+// DeleteWebhook removes a webhook with its queued deliveries and delivery log
+func (s *MemoryTaskStore) DeleteWebhook(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhooks[id]; !ok {
+		return fmt.Errorf("webhook with ID %s: %w", id, ErrWebhookNotFound)
+	}
+	delete(s.webhooks, id)
+	s.dropDeliveries(id)
+	s.attempts = slices.DeleteFunc(s.attempts, func(a *models.WebhookAttempt) bool {
+		return a.WebhookID == id
+	})
+	return nil
+}
+
+// ListWebhookDeliveries lists a webhook's delivery attempts, newest first
+func (s *MemoryTaskStore) ListWebhookDeliveries(ctx context.Context, req *models.ListWebhookDeliveriesRequest) (*models.ListWebhookDeliveriesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.webhooks[req.WebhookID]; !ok {
+		return nil, fmt.Errorf("webhook with ID %s: %w", req.WebhookID, ErrWebhookNotFound)
+	}
+
+	var attempts []*models.WebhookAttempt
+	for i := len(s.attempts) - 1; i >= 0 && len(attempts) <= int(pageSize); i-- {
+		a := s.attempts[i]
+		if a.WebhookID != req.WebhookID || (req.Cursor != nil && a.ID >= req.Cursor.ID) {
+			continue
+		}
+		attempt := *a
+		attempts = append(attempts, &attempt)
+	}
+
+	return models.NewListWebhookDeliveriesResponse(attempts, pageSize), nil
+}
+
+// ClaimWebhookDeliveries leases due deliveries by pushing back their next attempt
+func (s *MemoryTaskStore) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var due []*queuedDelivery
+	for _, d := range s.deliveries {
+		if hook := s.webhooks[d.webhookID]; hook != nil && hook.DisabledAt == nil && !d.nextAttemptAt.After(now) {
+			due = append(due, d)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].nextAttemptAt.Equal(due[j].nextAttemptAt) {
+			return due[i].nextAttemptAt.Before(due[j].nextAttemptAt)
+		}
+		return due[i].ID < due[j].ID
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+
+	deliveries := make([]*models.WebhookDelivery, len(due))
+	for i, d := range due {
+		d.nextAttemptAt = now.Add(lease)
+		delivery := d.WebhookDelivery
+		delivery.Webhook = cloneWebhook(s.webhooks[d.webhookID])
+		deliveries[i] = &delivery
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt logs attempt and updates its delivery and webhook. Attempts for
+// webhooks deleted since the claim are discarded.
+func (s *MemoryTaskStore) RecordWebhookAttempt(ctx context.Context, attempt *models.WebhookAttempt, policy models.WebhookDisablePolicy) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.webhooks[attempt.WebhookID]
+	if !ok {
+		return false, nil
+	}
+
+	s.attemptSeq++
+	attempt.ID = s.attemptSeq
+	logged := *attempt
+	s.attempts = append(s.attempts, &logged)
+
+	if d, ok := s.deliveries[attempt.DeliveryID]; ok {
+		if attempt.NextAttemptAt == nil {
+			delete(s.deliveries, d.ID)
+		} else {
+			d.Attempts = attempt.Attempt
+			d.nextAttemptAt = *attempt.NextAttemptAt
+		}
+	}
+
+	hook := cloneWebhook(existing)
+	disabled := hook.RecordAttempt(attempt, policy)
+	s.webhooks[hook.ID] = hook
+	if disabled {
+		s.dropDeliveries(hook.ID)
+	}
+	return disabled, nil
+}
+
+// PruneWebhookDeliveries drops delivery log entries recorded before cutoff
+func (s *MemoryTaskStore) PruneWebhookDeliveries(ctx context.Context, cutoff time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(s.attempts)
+	s.attempts = slices.DeleteFunc(s.attempts, func(a *models.WebhookAttempt) bool {
+		return a.AttemptedAt.Before(cutoff)
+	})
+	return int64(n - len(s.attempts)), nil
+}
+
+// queueDeliveries queues the change described by entry for every enabled webhook
+// subscribed to one of types, which are ordered as by models.EventTypes. Callers must
+// hold s.mu.
+func (s *MemoryTaskStore) queueDeliveries(types []string, entry *models.TaskHistoryEntry, task *models.Task) {
+	if len(s.webhooks) == 0 {
+		return
+	}
+
+	payload, err := encodeEventPayload(entry, task)
+	if err != nil {
+		log.Printf("Skipping webhook deliveries: %v", err)
+		return
+	}
+
+	ids := make([]string, 0, len(s.webhooks))
+	for id := range s.webhooks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		hook := s.webhooks[id]
+		if hook.DisabledAt != nil {
+			continue
+		}
+		eventType, ok := hook.Accepts(types)
+		if !ok {
+			continue
+		}
+		s.pendingDeliveries = append(s.pendingDeliveries, &queuedDelivery{
+			WebhookDelivery: models.WebhookDelivery{
+				EventType: eventType,
+				TaskID:    entry.TaskID,
+				Payload:   payload,
+				CreatedAt: entry.OccurredAt,
+			},
+			webhookID:     id,
+			nextAttemptAt: entry.OccurredAt,
+		})
+	}
+}
+
+// dropDeliveries removes every queued delivery to a webhook. Callers must hold s.mu.
+func (s *MemoryTaskStore) dropDeliveries(webhookID string) {
+	for id, d := range s.deliveries {
+		if d.webhookID == webhookID {
+			delete(s.deliveries, id)
+		}
+	}
+}
+
+// cloneWebhook returns a copy so callers never share memory with the store
+func cloneWebhook(hook *models.Webhook) *models.Webhook {
+	clone := *hook
+	clone.EventTypes = slices.Clone(hook.EventTypes)
+	if hook.FailingSince != nil {
+		since := *hook.FailingSince
+		clone.FailingSince = &since
+	}
+	if hook.DisabledAt != nil {
+		disabledAt := *hook.DisabledAt
+		clone.DisabledAt = &disabledAt
+	}
+	return &clone
+}
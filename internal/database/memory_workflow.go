@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// TransitionTask moves a task to another status if the workflow allows it and its version matches
+func (s *MemoryTaskStore) TransitionTask(ctx context.Context, req *models.TransitionTaskRequest) (*models.Task, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.live(req.ID)
+	if !ok {
+		return nil, fmt.Errorf("task with ID %s: %w", req.ID, ErrNotFound)
+	}
+	if req.Version != 0 && existing.Version != req.Version {
+		return nil, fmt.Errorf("task with ID %s is at version %d: %w", req.ID, existing.Version, ErrConflict)
+	}
+	if existing.Status == req.Status {
+		// Nothing changed, so keep the version
+		return cloneTask(existing), nil
+	}
+
+	updated, err := transition(s.workflow, existing, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	updated.Version++
+	updated = cloneTask(updated) // detach from existing's time pointers
+	next, err := s.nextOccurrence(existing, updated)
+	if err != nil {
+		return nil, err
+	}
+	s.tasks[req.ID] = updated
+	s.record(ctx, models.ChangeUpdated, existing, updated)
+	if next != nil {
+		s.insert(ctx, next)
+	}
+	s.flush()
+	return cloneTask(updated), nil
+}
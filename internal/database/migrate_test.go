@@ -0,0 +1,83 @@
+package database
+
+import (
+	"io/fs"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	// Every file follows NNNN_name.(up|down).sql and every up has its down
+	fileName := regexp.MustCompile(`^(\d{4}_[a-z0-9_]+)\.(up|down)\.sql$`)
+	names, err := fs.Glob(migrationFiles, "migrations/*")
+	if err != nil {
+		t.Fatalf("list migrations: %v", err)
+	}
+	directions := make(map[string][]string)
+	for _, name := range names {
+		match := fileName.FindStringSubmatch(strings.TrimPrefix(name, "migrations/"))
+		if match == nil {
+			t.Errorf("migration file %s is not named NNNN_name.(up|down).sql", name)
+			continue
+		}
+		directions[match[1]] = append(directions[match[1]], match[2])
+	}
+	for base, dirs := range directions {
+		if len(dirs) != 2 {
+			t.Errorf("migration %s has only a %s file", base, dirs[0])
+		}
+	}
+
+	// Versions run 1, 2, 3, ... without gaps
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i, m := range migrations {
+		if m.Version != int64(i+1) {
+			t.Fatalf("migration %d_%s follows version %d; versions must be contiguous", m.Version, m.Name, i)
+		}
+		if strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			t.Errorf("migration %d_%s has an empty up or down file", m.Version, m.Name)
+		}
+	}
+}
+
+func TestLoadMigrationsRejects(t *testing.T) {
+	sql := &fstest.MapFile{Data: []byte("SELECT 1;")}
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{"missing down", fstest.MapFS{"migrations/0001_a.up.sql": sql}},
+		{"missing up", fstest.MapFS{"migrations/0001_a.down.sql": sql}},
+		{"unknown direction", fstest.MapFS{"migrations/0001_a.sideways.sql": sql}},
+		{"no direction", fstest.MapFS{"migrations/0001_a.sql": sql}},
+		{"bad version", fstest.MapFS{"migrations/first_a.up.sql": sql, "migrations/first_a.down.sql": sql}},
+		{"zero version", fstest.MapFS{"migrations/0000_a.up.sql": sql, "migrations/0000_a.down.sql": sql}},
+		{"version reused", fstest.MapFS{"migrations/0001_a.up.sql": sql, "migrations/0001_b.down.sql": sql}},
+	}
+	for _, tt := range tests {
+		if _, err := loadMigrations(tt.files); err == nil {
+			t.Errorf("%s: loadMigrations succeeded", tt.name)
+		}
+	}
+
+	migrations, err := loadMigrations(fstest.MapFS{
+		"migrations/0002_b.up.sql":   sql,
+		"migrations/0002_b.down.sql": sql,
+		"migrations/0001_a.up.sql":   sql,
+		"migrations/0001_a.down.sql": sql,
+	})
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+	if len(migrations) != 2 || migrations[0].Name != "a" || migrations[1].Version != 2 {
+		t.Errorf("loadMigrations = %+v, want a then b", migrations)
+	}
+}
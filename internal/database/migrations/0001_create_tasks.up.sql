@@ -0,0 +1,9 @@
+-- Baseline schema; IF NOT EXISTS adopts databases created before migrations existed
+CREATE TABLE IF NOT EXISTS tasks (
+	id TEXT PRIMARY KEY,
+	title TEXT NOT NULL,
+	description TEXT,
+	completed BOOLEAN DEFAULT FALSE,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
@@ -0,0 +1,6 @@
+-- Existing rows hold RFC3339 text, which PostgreSQL parses directly
+ALTER TABLE tasks
+	ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at::timestamptz,
+	ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at::timestamptz;
+
+CREATE INDEX IF NOT EXISTS tasks_created_at_id_idx ON tasks (created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+-- Optimistic concurrency: bumped on every update
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
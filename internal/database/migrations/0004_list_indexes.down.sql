@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tasks_title_id_idx;
+DROP INDEX IF EXISTS tasks_updated_at_id_idx;
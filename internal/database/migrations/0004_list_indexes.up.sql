@@ -0,0 +1,3 @@
+-- Support keyset pagination for the other ListTasks orderings
+CREATE INDEX IF NOT EXISTS tasks_updated_at_id_idx ON tasks (updated_at, id);
+CREATE INDEX IF NOT EXISTS tasks_title_id_idx ON tasks (title, id);
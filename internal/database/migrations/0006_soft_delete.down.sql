@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS tasks_deleted_at_id_idx;
+-- Rolling back drops everything still in the trash
+DELETE FROM tasks WHERE deleted_at IS NOT NULL;
+ALTER TABLE tasks DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,4 @@
+-- Deleted tasks keep their row until the purger removes them
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS tasks_deleted_at_id_idx ON tasks (deleted_at DESC, id DESC) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS tasks_record_change ON tasks;
+DROP FUNCTION IF EXISTS record_task_change();
+DROP TABLE IF EXISTS task_changes;
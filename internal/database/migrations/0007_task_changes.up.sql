@@ -0,0 +1,40 @@
+-- Change feed for WatchTasks: every write to tasks is recorded here and announced
+-- with NOTIFY task_changes, carrying the change's seq
+CREATE TABLE IF NOT EXISTS task_changes (
+    seq         BIGSERIAL PRIMARY KEY,
+    task_id     TEXT NOT NULL,
+    change_type TEXT NOT NULL,
+    task        JSONB NOT NULL,
+    changed_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS task_changes_changed_at_idx ON task_changes (changed_at);
+
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, to_jsonb(NEW) - 'search_vector')
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS tasks_record_change ON tasks;
+CREATE TRIGGER tasks_record_change
+    AFTER INSERT OR UPDATE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION record_task_change();
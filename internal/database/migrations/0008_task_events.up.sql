@@ -0,0 +1,14 @@
+-- Audit log written in the same transaction as every task mutation. There is no
+-- foreign key so history survives the task being purged.
+CREATE TABLE IF NOT EXISTS task_events (
+    id          BIGSERIAL PRIMARY KEY,
+    task_id     TEXT NOT NULL,
+    event_type  TEXT NOT NULL,
+    actor       TEXT NOT NULL,
+    request_id  TEXT NOT NULL DEFAULT '',
+    changes     JSONB NOT NULL DEFAULT '[]',
+    version     BIGINT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS task_events_task_id_idx ON task_events (task_id, id DESC);
@@ -0,0 +1,17 @@
+-- Messages for external systems, written in the same transaction as each task change
+-- and deleted once the relay has delivered them. Dead-lettered rows stay for
+-- inspection; requeue them with
+--   UPDATE outbox SET dead_lettered_at = NULL, attempts = 0, next_attempt_at = now() WHERE ...
+CREATE TABLE IF NOT EXISTS outbox (
+    id               BIGSERIAL PRIMARY KEY,
+    topic            TEXT NOT NULL,
+    aggregate_id     TEXT NOT NULL,
+    payload          JSONB NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    attempts         INT NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error       TEXT NOT NULL DEFAULT '',
+    dead_lettered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_due_idx ON outbox (next_attempt_at, id) WHERE dead_lettered_at IS NULL;
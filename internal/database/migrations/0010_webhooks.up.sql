@@ -0,0 +1,46 @@
+-- Webhook subscriptions; an empty event_types subscribes to every event
+CREATE TABLE IF NOT EXISTS webhooks (
+    id              TEXT PRIMARY KEY,
+    url             TEXT NOT NULL,
+    event_types     TEXT[] NOT NULL DEFAULT '{}',
+    secret          TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    failure_count   INT NOT NULL DEFAULT 0,
+    failing_since   TIMESTAMPTZ,
+    disabled_at     TIMESTAMPTZ,
+    disabled_reason TEXT NOT NULL DEFAULT ''
+);
+
+-- Events waiting to be delivered to one webhook, queued in the same transaction as
+-- the task change. Rows are deleted once delivered or given up on.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              BIGSERIAL PRIMARY KEY,
+    webhook_id      TEXT NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_type      TEXT NOT NULL,
+    task_id         TEXT NOT NULL,
+    payload         JSONB NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at, id);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id);
+
+-- Delivery log: one row per attempt, kept after the delivery itself is gone
+CREATE TABLE IF NOT EXISTS webhook_attempts (
+    id              BIGSERIAL PRIMARY KEY,
+    delivery_id     BIGINT NOT NULL,
+    webhook_id      TEXT NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_type      TEXT NOT NULL,
+    task_id         TEXT NOT NULL,
+    attempt         INT NOT NULL,
+    status_code     INT NOT NULL DEFAULT 0,
+    error           TEXT NOT NULL DEFAULT '',
+    duration_ms     BIGINT NOT NULL DEFAULT 0,
+    attempted_at    TIMESTAMPTZ NOT NULL,
+    next_attempt_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS webhook_attempts_webhook_id_idx ON webhook_attempts (webhook_id, id DESC);
+CREATE INDEX IF NOT EXISTS webhook_attempts_attempted_at_idx ON webhook_attempts (attempted_at);
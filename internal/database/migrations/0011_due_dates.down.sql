@@ -0,0 +1,29 @@
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, to_jsonb(NEW) - 'search_vector')
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS tasks_pending_reminders_idx;
+DROP INDEX IF EXISTS tasks_due_at_id_idx;
+ALTER TABLE tasks DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS remind_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_at;
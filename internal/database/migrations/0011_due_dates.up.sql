@@ -0,0 +1,38 @@
+-- Deadlines and reminders; reminded_at is set once a reminder has fired and cleared
+-- whenever remind_at changes
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_at TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS remind_at TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS tasks_due_at_id_idx ON tasks (due_at, id)
+    WHERE deleted_at IS NULL AND due_at IS NOT NULL;
+-- Keeps the reminder scheduler's scan to reminders that have not fired yet
+CREATE INDEX IF NOT EXISTS tasks_pending_reminders_idx ON tasks (remind_at, id)
+    WHERE remind_at IS NOT NULL AND reminded_at IS NULL AND deleted_at IS NULL;
+
+-- Report a fired reminder as its own change kind on the change feed
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSIF OLD.reminded_at IS NULL AND NEW.reminded_at IS NOT NULL THEN
+        kind := 'reminded';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, to_jsonb(NEW) - 'search_vector')
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
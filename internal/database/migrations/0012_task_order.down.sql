@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS tasks_position_id_idx;
+DROP INDEX IF EXISTS tasks_priority_id_idx;
+DROP FUNCTION IF EXISTS task_position_key(BIGINT);
+ALTER TABLE tasks DROP COLUMN IF EXISTS position;
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,25 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 0;
+
+-- Rank keys for the manual order (see package rank); they only sort correctly bytewise
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS position TEXT COLLATE "C";
+
+-- The n-th key of an evenly spread list, the same keys as rank.Key
+CREATE OR REPLACE FUNCTION task_position_key(n BIGINT) RETURNS TEXT AS $$
+    SELECT 'e' || string_agg(
+        substr('0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz',
+               (n / (62 ^ p)::BIGINT % 62)::INT + 1, 1),
+        '' ORDER BY p DESC)
+    FROM generate_series(0, 4) AS p
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Existing tasks start in creation order; the backfill is not a change watchers need to see
+ALTER TABLE tasks DISABLE TRIGGER tasks_record_change;
+UPDATE tasks t SET position = task_position_key(o.n)
+FROM (SELECT id, row_number() OVER (ORDER BY created_at, id) - 1 AS n FROM tasks) o
+WHERE t.id = o.id;
+ALTER TABLE tasks ENABLE TRIGGER tasks_record_change;
+
+ALTER TABLE tasks ALTER COLUMN position SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS tasks_priority_id_idx ON tasks (priority, id);
+CREATE INDEX IF NOT EXISTS tasks_position_id_idx ON tasks (position, id);
@@ -0,0 +1,29 @@
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSIF OLD.reminded_at IS NULL AND NEW.reminded_at IS NOT NULL THEN
+        kind := 'reminded';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, to_jsonb(NEW) - 'search_vector')
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP FUNCTION IF EXISTS task_label_list(TEXT);
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
@@ -0,0 +1,53 @@
+-- Labels are shared by every task; names are unique ignoring case
+CREATE TABLE IF NOT EXISTS labels (
+    id         TEXT PRIMARY KEY,
+    name       TEXT NOT NULL,
+    color      TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS labels_lower_name_key ON labels (lower(name));
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id  TEXT NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    label_id TEXT NOT NULL REFERENCES labels (id) ON DELETE CASCADE,
+    PRIMARY KEY (task_id, label_id)
+);
+
+-- The primary key serves a task's labels; this serves the label filters of ListTasks
+CREATE INDEX IF NOT EXISTS task_labels_label_id_task_id_idx ON task_labels (label_id, task_id);
+
+-- A task's labels as the JSON array scanned into models.LabelList
+CREATE OR REPLACE FUNCTION task_label_list(task TEXT) RETURNS JSONB AS $$
+    SELECT COALESCE(jsonb_agg(jsonb_build_object('id', l.id, 'name', l.name, 'color', l.color)
+                              ORDER BY l.name, l.id), '[]'::jsonb)
+    FROM task_labels tl JOIN labels l ON l.id = tl.label_id
+    WHERE tl.task_id = task
+$$ LANGUAGE sql STABLE;
+
+-- Include the labels in the task snapshots sent to watchers
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSIF OLD.reminded_at IS NULL AND NEW.reminded_at IS NOT NULL THEN
+        kind := 'reminded';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, (to_jsonb(NEW) - 'search_vector') || jsonb_build_object('labels', task_label_list(NEW.id)))
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
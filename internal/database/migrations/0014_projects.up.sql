@@ -0,0 +1,18 @@
+-- Projects group tasks; every task belongs to exactly one
+CREATE TABLE IF NOT EXISTS projects (
+    id          TEXT PRIMARY KEY,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The default project (models.DefaultProjectID) takes tasks created without one,
+-- starting with every existing task
+INSERT INTO projects (id, name) VALUES ('default', 'Inbox') ON CONFLICT (id) DO NOTHING;
+
+-- A constant default fills existing rows without rewriting them or firing triggers
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS project_id TEXT NOT NULL DEFAULT 'default' REFERENCES projects (id);
+
+-- Serves the project filter of ListTasks and each project's manual order
+CREATE INDEX IF NOT EXISTS tasks_project_id_position_id_idx ON tasks (project_id, position, id);
@@ -0,0 +1,6 @@
+-- A subtask points at its parent. Purging a parent makes its subtasks top-level tasks;
+-- trashing it leaves them where they are.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id TEXT REFERENCES tasks (id) ON DELETE SET NULL;
+
+-- Serves the parent filter of ListTasks and walking down task trees
+CREATE INDEX IF NOT EXISTS tasks_parent_id_idx ON tasks (parent_id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,56 @@
+-- task_id is blocked by blocker_id: it is not to be completed before the blocker
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id    TEXT NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    blocker_id TEXT NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (task_id, blocker_id),
+    CHECK (task_id <> blocker_id)
+);
+
+-- The primary key serves a task's blockers; this serves the tasks a blocker blocks
+CREATE INDEX IF NOT EXISTS task_dependencies_blocker_id_idx ON task_dependencies (blocker_id);
+
+-- A task's blocker IDs as the JSON array scanned into models.IDList
+CREATE OR REPLACE FUNCTION task_blocker_list(task TEXT) RETURNS JSONB AS $$
+    SELECT COALESCE(jsonb_agg(blocker_id ORDER BY blocker_id), '[]'::jsonb)
+    FROM task_dependencies
+    WHERE task_id = task
+$$ LANGUAGE sql STABLE;
+
+-- Whether a task has a blocker that is neither completed nor in the trash
+CREATE OR REPLACE FUNCTION task_blocked(task TEXT) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM task_dependencies d JOIN tasks b ON b.id = d.blocker_id
+        WHERE d.task_id = task AND b.deleted_at IS NULL AND NOT b.completed
+    )
+$$ LANGUAGE sql STABLE;
+
+-- Include the dependencies in the task snapshots sent to watchers
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSIF OLD.reminded_at IS NULL AND NEW.reminded_at IS NOT NULL THEN
+        kind := 'reminded';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, (to_jsonb(NEW) - 'search_vector') || jsonb_build_object(
+        'labels', task_label_list(NEW.id),
+        'blocked_by', task_blocker_list(NEW.id),
+        'blocked', task_blocked(NEW.id)))
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
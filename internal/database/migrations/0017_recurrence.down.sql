@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tasks_series_occurrence_key;
+ALTER TABLE tasks DROP COLUMN IF EXISTS occurrence, DROP COLUMN IF EXISTS series_id, DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,9 @@
+-- A recurring task carries its RRULE. Completing it creates the next occurrence, which
+-- shares the series_id of the first task and numbers itself in the series.
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS series_id TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS occurrence INTEGER NOT NULL DEFAULT 0;
+
+-- Each occurrence is created once, however often the one before it is completed again
+CREATE UNIQUE INDEX IF NOT EXISTS tasks_series_occurrence_key ON tasks (series_id, occurrence) WHERE series_id <> '';
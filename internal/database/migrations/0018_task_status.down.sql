@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tasks_project_id_status_idx;
+ALTER TABLE tasks DROP COLUMN IF EXISTS completed_at, DROP COLUMN IF EXISTS status;
@@ -0,0 +1,14 @@
+-- A task's status comes from the configured workflow. completed stays, kept equal to
+-- whether the status is a done one, so filters and blockers keep working unchanged.
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'todo',
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+-- Existing tasks map onto the default workflow, and the server moves them into any other
+-- configured one at startup; the backfill is not a change watchers need to see
+ALTER TABLE tasks DISABLE TRIGGER tasks_record_change;
+UPDATE tasks SET status = 'done', completed_at = updated_at WHERE completed;
+ALTER TABLE tasks ENABLE TRIGGER tasks_record_change;
+
+-- Serves listing the columns of a board
+CREATE INDEX IF NOT EXISTS tasks_project_id_status_idx ON tasks (project_id, status) WHERE deleted_at IS NULL;
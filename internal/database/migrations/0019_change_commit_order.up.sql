@@ -0,0 +1,43 @@
+-- Record changes at commit rather than as rows are written. BIGSERIAL hands out seqs in
+-- insert order, so a transaction that took seq N-1 could commit after seq N had been
+-- delivered and be skipped by every watcher resuming from N. Taking seqs under a
+-- transaction-scoped advisory lock, at commit, makes seq order the commit order. A
+-- transaction only waits for the lock once the rest of its work is done, so the wait
+-- cannot deadlock with the row locks writers take.
+CREATE OR REPLACE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    kind       TEXT;
+    change_seq BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        kind := 'deleted';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        kind := 'restored';
+    ELSIF OLD.reminded_at IS NULL AND NEW.reminded_at IS NOT NULL THEN
+        kind := 'reminded';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    -- Held until the transaction ends, so no later seq can commit first ("change")
+    PERFORM pg_advisory_xact_lock(7163082334141743104);
+
+    INSERT INTO task_changes (task_id, change_type, task)
+    VALUES (NEW.id, kind, (to_jsonb(NEW) - 'search_vector') || jsonb_build_object(
+        'labels', task_label_list(NEW.id),
+        'blocked_by', task_blocker_list(NEW.id),
+        'blocked', task_blocked(NEW.id)))
+    RETURNING seq INTO change_seq;
+
+    PERFORM pg_notify('task_changes', change_seq::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS tasks_record_change ON tasks;
+CREATE CONSTRAINT TRIGGER tasks_record_change
+    AFTER INSERT OR UPDATE ON tasks
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION record_task_change();
@@ -0,0 +1 @@
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'todo';
@@ -0,0 +1,2 @@
+-- Tasks get their status from the configured workflow, whose initial status need not be todo
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
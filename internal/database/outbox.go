@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/outbox"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// outboxTopicPrefix prefixes the change type to form a message topic, e.g. "task.created"
+const outboxTopicPrefix = "task."
+
+// eventPayload is the JSON body of every task message in the outbox and in webhook
+// deliveries
+type eventPayload struct {
+	Type       string               `json:"type"`
+	TaskID     string               `json:"task_id"`
+	Task       *models.Task         `json:"task,omitempty"` // omitted for purges
+	Changes    []models.FieldChange `json:"changes"`
+	Actor      string               `json:"actor"`
+	RequestID  string               `json:"request_id,omitempty"`
+	Version    int64                `json:"version"`
+	OccurredAt time.Time            `json:"occurred_at"`
+}
+
+// encodeEventPayload renders the payload for the change described by entry; task is
+// the task right after the change, or nil when it was purged
+func encodeEventPayload(entry *models.TaskHistoryEntry, task *models.Task) ([]byte, error) {
+	payload, err := json.Marshal(eventPayload{
+		Type:       entry.Type,
+		TaskID:     entry.TaskID,
+		Task:       task,
+		Changes:    entry.Changes,
+		Actor:      entry.Actor,
+		RequestID:  entry.RequestID,
+		Version:    entry.Version,
+		OccurredAt: entry.OccurredAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event for task %s: %w", entry.TaskID, err)
+	}
+	return payload, nil
+}
+
+// outboxRow is one claimed row of the outbox table
+type outboxRow struct {
+	ID          int64     `db:"id"`
+	Topic       string    `db:"topic"`
+	AggregateID string    `db:"aggregate_id"`
+	Payload     []byte    `db:"payload"`
+	CreatedAt   time.Time `db:"created_at"`
+	Attempts    int       `db:"attempts"`
+}
+
+// taskChange is one task mutation to record in task_events, the outbox and webhook deliveries
+type taskChange struct {
+	kind   string
+	before *models.Task // nil for creates
+	after  *models.Task
+}
+
+// recordChanges writes the history entry, outbox message and webhook deliveries of
+// each change. Callers run it in the mutation's transaction and pass at most
+// insertChunkSize changes.
+func recordChanges(ctx context.Context, tx sqlx.ExecerContext, changes ...taskChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	entries := make([]*models.TaskHistoryEntry, len(changes))
+	payloads := make([][]byte, len(changes))
+	for i, c := range changes {
+		entries[i] = historyEntry(ctx, c.kind, c.before, c.after)
+		entries[i].OccurredAt = now
+
+		var err error
+		if payloads[i], err = encodeEventPayload(entries[i], c.after); err != nil {
+			return err
+		}
+	}
+	if err := recordHistory(ctx, tx, entries...); err != nil {
+		return err
+	}
+
+	values := make([]string, len(changes))
+	args := make([]interface{}, 0, len(changes)*3)
+	for i, c := range changes {
+		n := len(args)
+		values[i] = fmt.Sprintf("($%d, $%d, $%d::jsonb)", n+1, n+2, n+3)
+		args = append(args, outboxTopicPrefix+c.kind, c.after.ID, string(payloads[i]))
+	}
+
+	query := `INSERT INTO outbox (topic, aggregate_id, payload) VALUES ` + strings.Join(values, ", ")
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return wrapError(ctx, "enqueue outbox messages", err)
+	}
+
+	return enqueueWebhookDeliveries(ctx, tx, changes, payloads)
+}
+
+// DrainOutbox delivers due outbox messages inside one transaction. Rows are claimed
+// with SKIP LOCKED so several relays can run side by side; a crash before commit
+// releases them for redelivery. Once a message fails, later messages for the same
+// task in this batch are held back so each task's messages stay in order.
+func (r *TaskRepository) DrainOutbox(ctx context.Context, limit int, policy outbox.RetryPolicy, deliver func(context.Context, outbox.Message) error) (int, error) {
+	var claimed int
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		query := `
+    SELECT id, topic, aggregate_id, payload, created_at, attempts
+    FROM outbox
+    WHERE dead_lettered_at IS NULL AND next_attempt_at <= now()
+    ORDER BY id
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED`
+
+		var rows []outboxRow
+		if err := tx.SelectContext(ctx, &rows, query, limit); err != nil {
+			return wrapError(ctx, "claim outbox messages", err)
+		}
+		claimed = len(rows)
+
+		var delivered []int64
+		held := make(map[string]bool)
+		for _, row := range rows {
+			if held[row.AggregateID] {
+				continue
+			}
+
+			err := deliver(ctx, outbox.Message{
+				ID:        row.ID,
+				Topic:     row.Topic,
+				Key:       row.AggregateID,
+				Payload:   row.Payload,
+				CreatedAt: row.CreatedAt,
+				Attempt:   row.Attempts + 1,
+			})
+			if err == nil {
+				delivered = append(delivered, row.ID)
+				continue
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			held[row.AggregateID] = true
+			if err := recordFailure(ctx, tx, row, err, policy); err != nil {
+				return err
+			}
+		}
+
+		if len(delivered) > 0 {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM outbox WHERE id = ANY($1)`, pq.Array(delivered)); err != nil {
+				return wrapError(ctx, "delete delivered outbox messages", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return claimed, nil
+}
+
+// recordFailure schedules a retry of row, or dead-letters it once policy gives up
+func recordFailure(ctx context.Context, tx *sqlx.Tx, row outboxRow, cause error, policy outbox.RetryPolicy) error {
+	attempts := row.Attempts + 1
+
+	if policy.Exhausted(attempts) {
+		log.Printf("Dead-lettering outbox message %d (%s) after %d attempts: %v", row.ID, row.Topic, attempts, cause)
+		query := `UPDATE outbox SET attempts = $2, last_error = $3, dead_lettered_at = now() WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, row.ID, attempts, cause.Error()); err != nil {
+			return wrapError(ctx, "dead-letter outbox message", err)
+		}
+		return nil
+	}
+
+	query := `
+    UPDATE outbox
+    SET attempts = $2, last_error = $3, next_attempt_at = now() + make_interval(secs => $4)
+    WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, row.ID, attempts, cause.Error(), policy.Delay(attempts).Seconds()); err != nil {
+		return wrapError(ctx, "reschedule outbox message", err)
+	}
+	return nil
+}
+
+// TaskRepository is the outbox the relay drains in production
+var _ outbox.Source = (*TaskRepository)(nil)
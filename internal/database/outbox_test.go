@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/outbox"
+)
+
+func TestDrainOutbox(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewTaskRepository(db, testWorkflow(t))
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, `DELETE FROM outbox`); err != nil {
+		t.Fatalf("clear outbox: %v", err)
+	}
+
+	failing, other := newTestTask("failing"), newTestTask("other")
+	for _, task := range []*models.Task{failing, other} {
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+	failing.Title = "failing again"
+	if err := repo.UpdateTask(ctx, failing, []string{models.FieldTitle}, false); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	policy := outbox.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Hour}
+	var sent []outbox.Message
+	down := true
+	deliver := func(_ context.Context, msg outbox.Message) error {
+		sent = append(sent, msg)
+		if down && msg.Key == failing.ID {
+			return errors.New("sink unavailable")
+		}
+		return nil
+	}
+	drain := func() int {
+		t.Helper()
+		sent = nil
+		n, err := repo.DrainOutbox(ctx, 10, policy, deliver)
+		if err != nil {
+			t.Fatalf("DrainOutbox: %v", err)
+		}
+		return n
+	}
+	type row struct {
+		Topic        string     `db:"topic"`
+		Attempts     int        `db:"attempts"`
+		Due          bool       `db:"due"`
+		DeadLettered *time.Time `db:"dead_lettered_at"`
+	}
+	rows := func() []row {
+		t.Helper()
+		var rows []row
+		query := `SELECT topic, attempts, next_attempt_at <= now() AS due, dead_lettered_at FROM outbox ORDER BY id`
+		if err := db.SelectContext(ctx, &rows, query); err != nil {
+			t.Fatalf("read outbox: %v", err)
+		}
+		return rows
+	}
+
+	// The failed create holds back the update to the same task; the other task goes through
+	if n := drain(); n != 3 {
+		t.Fatalf("claimed %d messages, want 3", n)
+	}
+	if len(sent) != 2 || sent[0].Key != failing.ID || sent[0].Attempt != 1 || sent[1].Key != other.ID {
+		t.Fatalf("sent %+v, want the failing task's create then the other task's", sent)
+	}
+	got := rows()
+	if len(got) != 2 {
+		t.Fatalf("outbox holds %d messages, want the failing task's 2", len(got))
+	}
+	if got[0].Topic != "task.created" || got[0].Attempts != 1 || got[0].Due {
+		t.Errorf("failed message = %+v, want 1 attempt and a retry scheduled later", got[0])
+	}
+	if got[1].Topic != "task.updated" || got[1].Attempts != 0 || !got[1].Due {
+		t.Errorf("held message = %+v, want it untouched", got[1])
+	}
+
+	// Retries wait for the backoff, then dead-letter once the policy gives up
+	if _, err := db.ExecContext(ctx, `UPDATE outbox SET next_attempt_at = now() WHERE topic = 'task.created'`); err != nil {
+		t.Fatalf("expire backoff: %v", err)
+	}
+	if n := drain(); n != 2 || len(sent) != 1 {
+		t.Fatalf("claimed %d and sent %d messages, want 2 and 1", n, len(sent))
+	}
+	got = rows()
+	if len(got) != 2 || got[0].Attempts != 2 || got[0].DeadLettered == nil || got[1].Attempts != 0 {
+		t.Fatalf("outbox after the last attempt = %+v, want the create dead-lettered and the update held", got)
+	}
+
+	// Dead letters are not claimed again, and accepted messages are removed
+	down = false
+	if n := drain(); n != 1 || len(sent) != 1 || sent[0].Topic != "task.updated" {
+		t.Fatalf("claimed %d messages and sent %+v, want only the update", n, sent)
+	}
+	if got := rows(); len(got) != 1 || got[0].DeadLettered == nil {
+		t.Errorf("outbox holds %+v, want only the dead letter", got)
+	}
+}
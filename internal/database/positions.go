@@ -0,0 +1,223 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+	"github.com/jmoiron/sqlx"
+)
+
+// spreadLockKey is the pg_advisory_xact_lock key that serializes spreading positions
+const spreadLockKey int64 = 0x7370726561640000 // "spread"
+
+// spreadPositionsQuery renumbers every live task's position with evenly spaced keys,
+// keeping the current order of each project
+const spreadPositionsQuery = `
+    UPDATE tasks t SET position = task_position_key(o.n)
+    FROM (
+        SELECT id, row_number() OVER (PARTITION BY project_id ORDER BY position, id) - 1 AS n
+        FROM tasks WHERE deleted_at IS NULL
+    ) o
+    WHERE t.id = o.id AND t.position <> task_position_key(o.n)`
+
+// moveTaskQuery puts task $1 in project $2 at position $3
+const moveTaskQuery = `
+    UPDATE tasks
+    SET project_id = $2, position = $3, updated_at = now(), version = version + 1
+    WHERE id = $1 AND deleted_at IS NULL`
+
+// taskPosition is a task's place in the manual order of its project
+type taskPosition struct {
+	ID        string `db:"id"`
+	Position  string `db:"position"`
+	ProjectID string `db:"project_id"`
+}
+
+// before reports whether p is listed before o in the manual order
+func (p *taskPosition) before(o *taskPosition) bool {
+	return p.Position < o.Position || (p.Position == o.Position && p.ID < o.ID)
+}
+
+// MoveTask gives a task a position next to the requested neighbours, in another
+// project if requested
+func (r *TaskRepository) MoveTask(ctx context.Context, req *models.MoveTaskRequest) (*models.Task, error) {
+	var task *models.Task
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockTask(ctx, tx, req.ID, false, req.Version)
+		if err != nil {
+			return err
+		}
+
+		project := before.ProjectID
+		if req.ProjectID != "" && req.ProjectID != project {
+			if err := lockProject(ctx, tx, req.ProjectID); err != nil {
+				return err
+			}
+			project = req.ProjectID
+		}
+
+		after, next, err := neighbours(ctx, tx, req, project)
+		if err != nil {
+			return err
+		}
+		position, err := rank.Between(after, next)
+		if errors.Is(err, rank.ErrOutOfOrder) {
+			// The neighbours share a key, leaving no room between them
+			if _, err := spreadPositions(ctx, tx); err != nil {
+				return err
+			}
+			if after, next, err = neighbours(ctx, tx, req, project); err != nil {
+				return err
+			}
+			position, err = rank.Between(after, next)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to place task %s: %w", req.ID, err)
+		}
+
+		task, err = applyChange(ctx, tx, models.ChangeUpdated, before, moveTaskQuery, req.ID, project, position)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// RebalancePositions spreads out the manual order once a key is longer than maxLength
+func (r *TaskRepository) RebalancePositions(ctx context.Context, maxLength int) (int64, error) {
+	var spread int64
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		var long bool
+		query := `SELECT EXISTS (SELECT 1 FROM tasks WHERE deleted_at IS NULL AND length(position) > $1)`
+		if err := tx.GetContext(ctx, &long, query, maxLength); err != nil {
+			return wrapError(ctx, "check task positions", err)
+		}
+		if !long {
+			return nil
+		}
+
+		var err error
+		spread, err = spreadPositions(ctx, tx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return spread, nil
+}
+
+// spreadPositions renumbers the manual order inside tx and reports how many tasks
+// changed key. The rows change without a new version or history entry since their
+// order stays the same; watchers still see them as updates.
+func spreadPositions(ctx context.Context, tx *sqlx.Tx) (int64, error) {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, spreadLockKey); err != nil {
+		return 0, wrapError(ctx, "lock task positions", err)
+	}
+
+	result, err := tx.ExecContext(ctx, spreadPositionsQuery)
+	if err != nil {
+		return 0, wrapError(ctx, "spread task positions", err)
+	}
+	spread, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return spread, nil
+}
+
+// neighbours returns the positions a task moved per req into project goes between,
+// ignoring the moved task itself; "" stands for either end of the project's list
+func neighbours(ctx context.Context, tx *sqlx.Tx, req *models.MoveTaskRequest, project string) (string, string, error) {
+	switch {
+	case req.AfterID != "" && req.BeforeID != "":
+		after, err := anchorPosition(ctx, tx, req.AfterID, project)
+		if err != nil {
+			return "", "", err
+		}
+		next, err := anchorPosition(ctx, tx, req.BeforeID, project)
+		if err != nil {
+			return "", "", err
+		}
+		if !after.before(next) {
+			return "", "", fmt.Errorf("task %s is not listed before task %s: %w", req.AfterID, req.BeforeID, ErrConflict)
+		}
+		return after.Position, next.Position, nil
+
+	case req.AfterID != "":
+		after, err := anchorPosition(ctx, tx, req.AfterID, project)
+		if err != nil {
+			return "", "", err
+		}
+		next, err := adjacentPosition(ctx, tx, after, req.ID, false)
+		return after.Position, next, err
+
+	case req.BeforeID != "":
+		next, err := anchorPosition(ctx, tx, req.BeforeID, project)
+		if err != nil {
+			return "", "", err
+		}
+		after, err := adjacentPosition(ctx, tx, next, req.ID, true)
+		return after, next.Position, err
+
+	default:
+		last, err := lastPosition(ctx, tx, project, req.ID)
+		return last, "", err
+	}
+}
+
+// anchorPosition reads the position of a live task named as a neighbour, which must
+// be in project
+func anchorPosition(ctx context.Context, tx *sqlx.Tx, id, project string) (*taskPosition, error) {
+	var p taskPosition
+	query := `SELECT id, position, project_id FROM tasks WHERE id = $1 AND deleted_at IS NULL`
+	if err := tx.GetContext(ctx, &p, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("task with ID %s: %w", id, ErrNotFound)
+		}
+		return nil, wrapError(ctx, "get task position", err)
+	}
+	if p.ProjectID != project {
+		return nil, fmt.Errorf("task %s is not in project %s: %w", id, project, ErrInvalid)
+	}
+	return &p, nil
+}
+
+// adjacentPosition returns the position of the live task listed right after anchor
+// in its project, or right before it when previous is set, skipping the task with ID
+// skip. It returns "" when anchor is at that end of the list.
+func adjacentPosition(ctx context.Context, tx *sqlx.Tx, anchor *taskPosition, skip string, previous bool) (string, error) {
+	cmp, dir := ">", "ASC"
+	if previous {
+		cmp, dir = "<", "DESC"
+	}
+	query := fmt.Sprintf(`
+    SELECT position FROM tasks
+    WHERE deleted_at IS NULL AND project_id = $4 AND id <> $3 AND (position, id) %s ($1, $2)
+    ORDER BY position %s, id %s
+    LIMIT 1`, cmp, dir, dir)
+
+	var position string
+	if err := tx.GetContext(ctx, &position, query, anchor.Position, anchor.ID, skip, anchor.ProjectID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", wrapError(ctx, "get adjacent task position", err)
+	}
+	return position, nil
+}
+
+// lastPosition returns the highest position of a live task in project other than the
+// one with ID skip, or "" when there is none
+func lastPosition(ctx context.Context, tx *sqlx.Tx, project, skip string) (string, error) {
+	var last sql.NullString
+	query := `SELECT max(position) FROM tasks WHERE deleted_at IS NULL AND project_id = $1 AND id <> $2`
+	if err := tx.GetContext(ctx, &last, query, project, skip); err != nil {
+		return "", wrapError(ctx, "get last task position", err)
+	}
+	return last.String, nil
+}
@@ -10,15 +10,19 @@ import (
 
 type PostgresDB struct {
 	*sqlx.DB
+	connStr string // reused by the change listener's dedicated connection
 }
 
 type Config struct {
+	Driver   string // DriverPostgres or DriverMemory
 	Host     string
 	Port     int
 	Username string // Use one consistent field name
 	Password string
 	DBName   string // This should match what you use in the connection string
 	SSLMode  string
+
+	AutoMigrate bool // apply pending migrations when the server starts
 }
 
 func NewPostgresDB(cfg Config) (*PostgresDB, error) {
@@ -31,26 +35,7 @@ func NewPostgresDB(cfg Config) (*PostgresDB, error) {
 		return nil, fmt.Errorf("failed to connect to PostgreSQL database: %w", err)
 	}
 	log.Printf("Connected to PostgreSQL database at %s:%d", cfg.Host, cfg.Port)
-	return &PostgresDB{DB: db}, nil
-}
-
-func (db *PostgresDB) CreateTasksTable() error {
-	// Make schema match your Task protobuf definition
-	query := `
-	CREATE TABLE IF NOT EXISTS tasks (
-		id TEXT PRIMARY KEY,
-		title TEXT NOT NULL,
-		description TEXT,
-		completed BOOLEAN DEFAULT FALSE,
-		created_at TEXT NOT NULL,
-		updated_at TEXT NOT NULL
-	);
-	`
-	if _, err := db.Exec(query); err != nil {
-		return fmt.Errorf("failed to create tasks table: %w", err)
-	}
-	log.Println("Tasks table created successfully")
-	return nil
+	return &PostgresDB{DB: db, connStr: connStr}, nil
 }
 
 func (db *PostgresDB) Close() error {
@@ -0,0 +1,182 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// projectColumns is the column list scanned into models.Project
+const projectColumns = `id, name, description, created_at, updated_at`
+
+// trashToProjectQuery moves task $1 to the trash and to project $2
+const trashToProjectQuery = `
+    UPDATE tasks
+    SET deleted_at = now(), project_id = $2, version = version + 1
+    WHERE id = $1 AND deleted_at IS NULL`
+
+// CreateProject adds a new project
+func (r *TaskRepository) CreateProject(ctx context.Context, project *models.Project) error {
+	query := `
+    INSERT INTO projects (id, name, description, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, $5)`
+
+	project.CreatedAt = project.CreatedAt.Truncate(time.Microsecond)
+	project.UpdatedAt = project.UpdatedAt.Truncate(time.Microsecond)
+	_, err := r.db.ExecContext(ctx, query,
+		project.ID, project.Name, project.Description, project.CreatedAt, project.UpdatedAt)
+	if err != nil {
+		return wrapError(ctx, "create project "+project.ID, err)
+	}
+	return nil
+}
+
+// GetProject retrieves a project by ID
+func (r *TaskRepository) GetProject(ctx context.Context, id string) (*models.Project, error) {
+	var project models.Project
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE id = $1`
+	if err := r.db.GetContext(ctx, &project, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("project with ID %s: %w", id, ErrProjectNotFound)
+		}
+		return nil, wrapError(ctx, "get project", err)
+	}
+	return &project, nil
+}
+
+// UpdateProject renames or redescribes a project
+func (r *TaskRepository) UpdateProject(ctx context.Context, req *models.UpdateProjectRequest) (*models.Project, error) {
+	set := []string{"updated_at = now()"}
+	args := []interface{}{req.ID}
+	for _, field := range req.Fields() {
+		switch field {
+		case models.FieldProjectName:
+			args = append(args, req.Name)
+		case models.FieldProjectDescription:
+			args = append(args, req.Description)
+		default:
+			return nil, fmt.Errorf("cannot update unknown project field %q: %w", field, ErrInvalid)
+		}
+		set = append(set, fmt.Sprintf("%s = $%d", field, len(args)))
+	}
+	query := `UPDATE projects SET ` + strings.Join(set, ", ") + ` WHERE id = $1 RETURNING ` + projectColumns
+
+	var project models.Project
+	if err := r.db.GetContext(ctx, &project, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("project with ID %s: %w", req.ID, ErrProjectNotFound)
+		}
+		return nil, wrapError(ctx, "update project "+req.ID, err)
+	}
+	return &project, nil
+}
+
+// DeleteProject deletes a project after dealing with its tasks as req says
+func (r *TaskRepository) DeleteProject(ctx context.Context, req *models.DeleteProjectRequest) error {
+	if req.ID == models.DefaultProjectID {
+		return fmt.Errorf("cannot delete the default project: %w", ErrInvalid)
+	}
+
+	return r.inTx(ctx, func(tx *sqlx.Tx) error {
+		// Blocks tasks from being created in or moved to the project meanwhile
+		var id string
+		if err := tx.GetContext(ctx, &id, `SELECT id FROM projects WHERE id = $1 FOR UPDATE`, req.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("project with ID %s: %w", req.ID, ErrProjectNotFound)
+			}
+			return wrapError(ctx, "lock project", err)
+		}
+
+		var tasks []*models.Task
+		query := `SELECT ` + taskColumns + ` FROM tasks WHERE project_id = $1 AND deleted_at IS NULL
+    ORDER BY position, id FOR UPDATE`
+		if err := tx.SelectContext(ctx, &tasks, query, req.ID); err != nil {
+			return wrapError(ctx, "lock project tasks", err)
+		}
+
+		switch req.Tasks {
+		case models.ProjectDeletionRestrict:
+			if len(tasks) > 0 {
+				return fmt.Errorf("project with ID %s has %d tasks: %w", req.ID, len(tasks), ErrProjectNotEmpty)
+			}
+
+		case models.ProjectDeletionMove:
+			position, err := lastPosition(ctx, tx, models.DefaultProjectID, "")
+			if err != nil {
+				return err
+			}
+			for _, before := range tasks {
+				if position, err = rank.Between(position, ""); err != nil {
+					return err
+				}
+				_, err := applyChange(ctx, tx, models.ChangeUpdated, before, moveTaskQuery,
+					before.ID, models.DefaultProjectID, position)
+				if err != nil {
+					return err
+				}
+			}
+
+		case models.ProjectDeletionTrash:
+			for _, before := range tasks {
+				_, err := applyChange(ctx, tx, models.ChangeDeleted, before, trashToProjectQuery,
+					before.ID, models.DefaultProjectID)
+				if err != nil {
+					return err
+				}
+			}
+
+		default:
+			return fmt.Errorf("unknown project deletion mode %d: %w", req.Tasks, ErrInvalid)
+		}
+
+		// Only trashed tasks are left; like spreadPositions this is no new version
+		query = `UPDATE tasks SET project_id = $2 WHERE project_id = $1`
+		if _, err := tx.ExecContext(ctx, query, req.ID, models.DefaultProjectID); err != nil {
+			return wrapError(ctx, "move trashed tasks of project "+req.ID, err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM projects WHERE id = $1`, req.ID); err != nil {
+			return wrapError(ctx, "delete project "+req.ID, err)
+		}
+		return nil
+	})
+}
+
+// ListProjects lists every project by name
+func (r *TaskRepository) ListProjects(ctx context.Context) ([]*models.Project, error) {
+	var projects []*models.Project
+	query := `SELECT ` + projectColumns + ` FROM projects ORDER BY name, id`
+	if err := r.db.SelectContext(ctx, &projects, query); err != nil {
+		return nil, wrapError(ctx, "list projects", err)
+	}
+	return projects, nil
+}
+
+// lockProject checks that a project exists and keeps it from being deleted until tx ends
+func lockProject(ctx context.Context, tx *sqlx.Tx, id string) error {
+	var found string
+	if err := tx.GetContext(ctx, &found, `SELECT id FROM projects WHERE id = $1 FOR KEY SHARE`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("project with ID %s: %w", id, ErrProjectNotFound)
+		}
+		return wrapError(ctx, "lock project", err)
+	}
+	return nil
+}
+
+// lockProjects is lockProject for several projects; it returns the ones that exist
+func lockProjects(ctx context.Context, tx *sqlx.Tx, ids []string) ([]string, error) {
+	var found []string
+	query := `SELECT id FROM projects WHERE id = ANY($1) ORDER BY id FOR KEY SHARE`
+	if err := tx.SelectContext(ctx, &found, query, pq.Array(ids)); err != nil {
+		return nil, wrapError(ctx, "lock projects", err)
+	}
+	return found, nil
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// createNextOccurrence creates the task that follows after in its series inside tx if
+// the update from before to after completed a recurring task. Each occurrence is created
+// once, so reopening and completing a task again adds nothing. The new task goes to the
+// end of the project and keeps the parent while that is live.
+func (r *TaskRepository) createNextOccurrence(ctx context.Context, tx *sqlx.Tx, before, after *models.Task) error {
+	if !models.CompletesOccurrence(before, after) {
+		return nil
+	}
+	next, err := after.NextOccurrence(uuid.New().String(), after.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("next occurrence of task %s: %w", after.ID, err)
+	}
+	if next == nil {
+		return nil
+	}
+	truncateTimes(next)
+	r.workflow.Settle(nil, next)
+
+	if next.ParentID != "" {
+		parents, err := lockParents(ctx, tx, []string{next.ParentID})
+		if err != nil {
+			return err
+		}
+		if _, ok := parents[next.ParentID]; !ok {
+			next.ParentID = ""
+		}
+	}
+	if err := lockProject(ctx, tx, next.ProjectID); err != nil {
+		return err
+	}
+	last, err := lastPosition(ctx, tx, next.ProjectID, "")
+	if err != nil {
+		return err
+	}
+	if next.Position, err = rank.Between(last, ""); err != nil {
+		return err
+	}
+
+	query := `
+    INSERT INTO tasks (` + strings.Join(insertColumns, ", ") + `) VALUES ` + insertRow(0) + `
+    ON CONFLICT (series_id, occurrence) WHERE series_id <> '' DO NOTHING
+    RETURNING id`
+
+	var ids []string
+	if err := tx.SelectContext(ctx, &ids, query, insertArgs(next)...); err != nil {
+		return wrapError(ctx, "create next occurrence of task "+after.ID, err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return recordChanges(ctx, tx, taskChange{kind: models.ChangeCreated, after: next})
+}
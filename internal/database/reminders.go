@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+)
+
+// FireReminders marks up to limit reminders due at now as fired and records a reminded
+// change for each in the same transaction. SKIP LOCKED lets several servers run the
+// scheduler without firing a reminder twice or waiting on each other.
+func (r *TaskRepository) FireReminders(ctx context.Context, now time.Time, limit int) ([]*models.Task, error) {
+	query := `
+    UPDATE tasks SET reminded_at = $1
+    WHERE id IN (
+        SELECT id FROM tasks
+        WHERE remind_at <= $1 AND reminded_at IS NULL AND deleted_at IS NULL AND NOT completed
+        ORDER BY remind_at, id
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING ` + taskColumns
+
+	var fired []*models.Task
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		if err := tx.SelectContext(ctx, &fired, query, now.Truncate(time.Microsecond), limit); err != nil {
+			return wrapError(ctx, "fire reminders", err)
+		}
+
+		// Firing a reminder only sets reminded_at, so the task before it is known
+		changes := make([]taskChange, len(fired))
+		for i, task := range fired {
+			before := *task
+			before.RemindedAt = nil
+			changes[i] = taskChange{kind: models.ChangeReminded, before: &before, after: task}
+		}
+		return recordChanges(ctx, tx, changes...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fired, nil
+}
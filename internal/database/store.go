@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+// Supported values for Config.Driver
+const (
+	DriverPostgres = "postgres"
+	DriverMemory   = "memory"
+)
+
+// TaskStore is the storage contract used by the gRPC handlers.
+// Implementations wrap the sentinel errors from errors.go so callers can use errors.Is.
+type TaskStore interface {
+	// CreateTask adds a task to task.ProjectID, or when it is empty to the project of
+	// task.ParentID or the default project. It fails with ErrProjectNotFound if the project
+	// does not exist and ErrParentNotFound if the parent is missing or trashed.
+	CreateTask(ctx context.Context, task *models.Task) error
+	GetTask(ctx context.Context, id string) (*models.Task, error)
+	// GetTaskTree returns a task with its subtasks nested req.Depth levels deep. Counts
+	// cover live subtasks at every level; trashed subtasks are left out with their own.
+	GetTaskTree(ctx context.Context, req *models.GetTaskTreeRequest) (*models.TaskNode, error)
+	ListTasks(ctx context.Context, req *models.ListTasksRequest) (*models.ListTasksResponse, error)
+	// UpdateTask writes the listed fields of task (all when fields is nil) if the stored
+	// version equals task.Version, then increments task.Version. A new ParentID fails
+	// like in CreateTask, or with ErrTaskCycle if it is the task or one of its subtasks.
+	// Completing a blocked task fails with ErrTaskBlocked unless ignoreBlockers is set.
+	// Changing Completed moves the task to a status as models.Workflow.Settle describes.
+	UpdateTask(ctx context.Context, task *models.Task, fields []string, ignoreBlockers bool) error
+	// TransitionTask moves a task to req.Status and returns it if the stored version equals
+	// req.Version (0 skips the check). It fails with ErrInvalidTransition if the workflow
+	// does not allow the move, and with ErrTaskBlocked like UpdateTask. Moving to the
+	// current status changes nothing.
+	TransitionTask(ctx context.Context, req *models.TransitionTaskRequest) (*models.Task, error)
+	// DeleteTask moves a task to the trash if its version equals version; 0 skips the check.
+	// Trashed tasks are hidden from every other method except the trash ones below.
+	DeleteTask(ctx context.Context, id string, version int64) error
+	// SearchTasks returns tasks matching a full-text query, best matches first
+	SearchTasks(ctx context.Context, req *models.SearchTasksRequest) (*models.SearchTasksResponse, error)
+
+	// Each project has its own manual order (task Positions, see package rank), and tasks
+	// are created at its end. MoveTask gives a task a position right after req.AfterID
+	// and/or right before req.BeforeID and returns it, first moving it to req.ProjectID
+	// when set, at the end if no neighbour is given. Neighbours in another project are
+	// ErrInvalid. It fails with ErrConflict when both are set but listed the other way
+	// round; neighbours that share a position are spread out first.
+	MoveTask(ctx context.Context, req *models.MoveTaskRequest) (*models.Task, error)
+	// RebalancePositions renumbers the manual order with short, evenly spaced keys once
+	// any key is longer than maxLength and reports how many tasks changed key. The order
+	// is unchanged, so tasks keep their version and history; watchers see updates.
+	RebalancePositions(ctx context.Context, maxLength int) (int64, error)
+
+	// RestoreTask moves a task out of the trash if its version equals version; 0 skips the check
+	RestoreTask(ctx context.Context, id string, version int64) (*models.Task, error)
+	// ListDeletedTasks lists trashed tasks, most recently deleted first
+	ListDeletedTasks(ctx context.Context, req *models.ListDeletedTasksRequest) (*models.ListDeletedTasksResponse, error)
+	// PurgeDeletedTasks permanently removes tasks deleted before cutoff and reports how
+	// many. Their subtasks become top-level tasks without a new version.
+	PurgeDeletedTasks(ctx context.Context, cutoff time.Time) (int64, error)
+
+	// FireReminders marks up to limit incomplete tasks whose reminder is due at now as
+	// reminded, oldest reminder first, and returns them. Each reminder fires once, even
+	// with several callers, and is recorded as a reminded change.
+	FireReminders(ctx context.Context, now time.Time, limit int) ([]*models.Task, error)
+
+	// Batch methods apply every item in one transaction and return a result per item,
+	// in order. With atomic set the first failing item aborts the batch, nothing is
+	// written and the error names the item; otherwise failed items are skipped and
+	// reported in their BatchResult while the rest are committed.
+	BatchCreateTasks(ctx context.Context, tasks []*models.Task, atomic bool) ([]models.BatchResult, error)
+	// BatchUpdateTasks applies each request to the stored task as UpdateTask would,
+	// with the request's IgnoreBlockers
+	BatchUpdateTasks(ctx context.Context, reqs []*models.UpdateTaskRequest, atomic bool) ([]models.BatchResult, error)
+	BatchDeleteTasks(ctx context.Context, reqs []*models.DeleteTaskRequest, atomic bool) ([]models.BatchResult, error)
+
+	// WatchTasks calls fn with every task change after seq (starting with the next change
+	// when seq is 0) until ctx is done or fn fails. It returns ErrExpired when seq is older
+	// than the retained history and ErrLagged when fn cannot keep up with the feed.
+	WatchTasks(ctx context.Context, seq int64, fn func(models.TaskEvent) error) error
+	// PruneTaskChanges drops change history recorded before cutoff and reports how many events
+	PruneTaskChanges(ctx context.Context, cutoff time.Time) (int64, error)
+
+	// ListTaskHistory lists the audited changes to a task, newest first. Every mutation
+	// above records its entry atomically with the change, attributed to the actor and
+	// request ID in ctx (see package audit); history is kept after a task is purged.
+	ListTaskHistory(ctx context.Context, req *models.ListTaskHistoryRequest) (*models.ListTaskHistoryResponse, error)
+
+	// Webhooks live alongside tasks so every mutation above can queue deliveries to
+	// the matching webhooks atomically with the change
+	WebhookStore
+
+	LabelStore
+
+	ProjectStore
+
+	DependencyStore
+}
+
+// DependencyStore keeps which tasks block which. A task is Blocked while one of its
+// blockers is neither completed nor trashed; purging a blocker drops the dependency.
+type DependencyStore interface {
+	// AddDependency makes req.TaskID blocked by req.BlockerID if the task's version
+	// matches and returns it. It fails with ErrBlockerNotFound if the blocker is missing
+	// or trashed, and with ErrDependencyCycle if the blocker is already blocked by the
+	// task, directly or through other tasks. An existing dependency changes nothing.
+	AddDependency(ctx context.Context, req *models.DependencyRequest) (*models.Task, error)
+	// RemoveDependency undoes AddDependency; a missing dependency changes nothing
+	RemoveDependency(ctx context.Context, req *models.DependencyRequest) (*models.Task, error)
+	// ListTasksInDependencyOrder lists live tasks with each after its blockers among
+	// them, in the manual order where the dependencies leave a choice
+	ListTasksInDependencyOrder(ctx context.Context, req *models.ListTasksInDependencyOrderRequest) ([]*models.Task, error)
+}
+
+// ProjectStore keeps the projects tasks belong to. models.DefaultProjectID always exists.
+type ProjectStore interface {
+	CreateProject(ctx context.Context, project *models.Project) error
+	// GetProject fails with ErrProjectNotFound if the project does not exist
+	GetProject(ctx context.Context, id string) (*models.Project, error)
+	UpdateProject(ctx context.Context, req *models.UpdateProjectRequest) (*models.Project, error)
+	// DeleteProject deletes a project, first moving or trashing its live tasks as req
+	// says. Trashed tasks move to the default project without a new version.
+	DeleteProject(ctx context.Context, req *models.DeleteProjectRequest) error
+	// ListProjects lists every project by name
+	ListProjects(ctx context.Context) ([]*models.Project, error)
+}
+
+// LabelStore keeps labels and which tasks carry them. Tasks read from any TaskStore
+// method include their current labels.
+type LabelStore interface {
+	// CreateLabel adds a label; it fails with ErrLabelExists if the name is taken
+	CreateLabel(ctx context.Context, label *models.Label) error
+	// UpdateLabel renames or recolors a label and returns it. Tasks carrying the label
+	// show the change without a new version or history entry.
+	UpdateLabel(ctx context.Context, req *models.UpdateLabelRequest) (*models.Label, error)
+	// DeleteLabel removes a label from every task, again without recording task changes
+	DeleteLabel(ctx context.Context, id string) error
+	// ListLabels lists every label by name
+	ListLabels(ctx context.Context) ([]*models.Label, error)
+
+	// AddTaskLabels attaches labels to a task if its version matches and returns it. Labels
+	// the task already has are skipped; if it had them all, nothing is written.
+	AddTaskLabels(ctx context.Context, req *models.TaskLabelsRequest) (*models.Task, error)
+	// RemoveTaskLabels detaches labels from a task like AddTaskLabels attaches them
+	RemoveTaskLabels(ctx context.Context, req *models.TaskLabelsRequest) (*models.Task, error)
+}
+
+// WebhookStore keeps webhook subscriptions, their delivery queue and delivery log
+type WebhookStore interface {
+	CreateWebhook(ctx context.Context, hook *models.Webhook) error
+	// ListWebhooks lists every webhook, oldest first
+	ListWebhooks(ctx context.Context) ([]*models.Webhook, error)
+	// DeleteWebhook removes a webhook with its pending deliveries and delivery log
+	DeleteWebhook(ctx context.Context, id string) error
+	// ListWebhookDeliveries lists the attempts made to deliver to a webhook, newest first
+	ListWebhookDeliveries(ctx context.Context, req *models.ListWebhookDeliveriesRequest) (*models.ListWebhookDeliveriesResponse, error)
+
+	// ClaimWebhookDeliveries leases up to limit due deliveries to enabled webhooks, oldest
+	// first. A delivery whose attempt is not recorded within lease is claimed again.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	// RecordWebhookAttempt logs an attempt at a claimed delivery, then retries it at
+	// attempt.NextAttemptAt or drops it from the queue when that is nil. It updates the
+	// webhook's failure streak and reports whether policy disabled it; a disabled webhook
+	// loses its queued deliveries and receives no new ones.
+	RecordWebhookAttempt(ctx context.Context, attempt *models.WebhookAttempt, policy models.WebhookDisablePolicy) (bool, error)
+	// PruneWebhookDeliveries drops delivery log entries recorded before cutoff and reports how many
+	PruneWebhookDeliveries(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// Compile-time checks that both implementations satisfy TaskStore
+var (
+	_ TaskStore = (*TaskRepository)(nil)
+	_ TaskStore = (*MemoryTaskStore)(nil)
+)
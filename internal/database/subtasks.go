@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// hierarchyLockKey is the pg_advisory_xact_lock key that serializes re-parenting tasks,
+// so two concurrent moves cannot close a cycle the other did not see
+const hierarchyLockKey int64 = 0x706172656e740000 // "parent"
+
+// taskTreeQuery walks down from live task $1 through its live subtasks. Every level is
+// walked for the counts; only tasks at most $2 levels down are returned.
+const taskTreeQuery = `
+    WITH RECURSIVE tree (id, depth, path) AS (
+        SELECT id, 0, ARRAY[id] FROM tasks WHERE id = $1 AND deleted_at IS NULL
+        UNION ALL
+        SELECT t.id, tree.depth + 1, tree.path || t.id
+        FROM tasks t JOIN tree ON t.parent_id = tree.id
+        WHERE t.deleted_at IS NULL AND NOT t.id = ANY (tree.path)
+    ), rollup AS (
+        SELECT ancestor AS id, count(*) AS subtasks, count(*) FILTER (WHERE t.completed) AS completed_subtasks
+        FROM tree JOIN tasks t USING (id), unnest(tree.path[1:cardinality(tree.path) - 1]) AS ancestor
+        GROUP BY ancestor
+    )
+    SELECT ` + taskColumns + `,
+        COALESCE(rollup.subtasks, 0) AS subtasks, COALESCE(rollup.completed_subtasks, 0) AS completed_subtasks
+    FROM tree JOIN tasks USING (id) LEFT JOIN rollup USING (id)
+    WHERE tree.depth <= $2`
+
+// GetTaskTree returns a live task with its live subtasks nested req.Depth levels deep
+func (r *TaskRepository) GetTaskTree(ctx context.Context, req *models.GetTaskTreeRequest) (*models.TaskNode, error) {
+	var rows []struct {
+		models.Task
+		Subtasks          int `db:"subtasks"`
+		CompletedSubtasks int `db:"completed_subtasks"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, taskTreeQuery, req.ID, req.TreeDepth()); err != nil {
+		return nil, wrapError(ctx, "get task tree", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("task with ID %s: %w", req.ID, ErrNotFound)
+	}
+
+	nodes := make([]*models.TaskNode, len(rows))
+	for i := range rows {
+		task := rows[i].Task
+		nodes[i] = &models.TaskNode{
+			Task:              &task,
+			SubtaskCount:      rows[i].Subtasks,
+			CompletedSubtasks: rows[i].CompletedSubtasks,
+		}
+	}
+	return models.NewTaskTree(req.ID, nodes), nil
+}
+
+// updatesParent reports whether an update of fields (all when nil) may re-parent a task
+func updatesParent(fields []string) bool {
+	return fields == nil || slices.Contains(fields, models.FieldParentID)
+}
+
+// checkParent checks inside tx that task id may become a subtask of parentID: the
+// parent must be live, and neither id itself nor one of its subtasks. Checks are
+// serialized until tx ends, so concurrent re-parenting cannot form a cycle.
+func checkParent(ctx context.Context, tx *sqlx.Tx, id, parentID string) error {
+	if parentID == "" {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, hierarchyLockKey); err != nil {
+		return wrapError(ctx, "lock task hierarchy", err)
+	}
+
+	// Trashed ancestors still count: restoring them must not complete a cycle
+	query := `
+    WITH RECURSIVE ancestors (id, parent_id) AS (
+        SELECT id, parent_id FROM tasks WHERE id = $1
+        UNION
+        SELECT t.id, t.parent_id FROM tasks t JOIN ancestors a ON t.id = a.parent_id
+    )
+    SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND deleted_at IS NULL) AS found,
+        EXISTS (SELECT 1 FROM ancestors WHERE id = $2) AS cycle`
+
+	var check struct {
+		Found bool `db:"found"`
+		Cycle bool `db:"cycle"`
+	}
+	if err := tx.GetContext(ctx, &check, query, parentID, id); err != nil {
+		return wrapError(ctx, "check parent of task "+id, err)
+	}
+	if !check.Found {
+		return fmt.Errorf("task with ID %s: %w", parentID, ErrParentNotFound)
+	}
+	if check.Cycle {
+		return fmt.Errorf("task with ID %s is a subtask of task %s: %w", parentID, id, ErrTaskCycle)
+	}
+	return nil
+}
+
+// lockParents maps those of ids that name live tasks to the tasks' projects and keeps
+// the tasks from being purged until tx ends
+func lockParents(ctx context.Context, tx *sqlx.Tx, ids []string) (map[string]string, error) {
+	var found []struct {
+		ID        string `db:"id"`
+		ProjectID string `db:"project_id"`
+	}
+	query := `SELECT id, project_id FROM tasks WHERE id = ANY($1) AND deleted_at IS NULL ORDER BY id FOR KEY SHARE`
+	if err := tx.SelectContext(ctx, &found, query, pq.Array(ids)); err != nil {
+		return nil, wrapError(ctx, "lock parent tasks", err)
+	}
+
+	parents := make(map[string]string, len(found))
+	for _, parent := range found {
+		parents[parent.ID] = parent.ProjectID
+	}
+	return parents, nil
+}
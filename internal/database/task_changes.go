@@ -0,0 +1,215 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/lib/pq"
+)
+
+// changeChannel is the NOTIFY channel the tasks trigger announces changes on
+const changeChannel = "task_changes"
+
+// listenerPingInterval keeps the listener connection from idling out unnoticed
+const listenerPingInterval = 90 * time.Second
+
+// changeColumns is the column list scanned into changeRow
+const changeColumns = `seq, change_type, task, changed_at`
+
+// changeRow is one row of the task_changes table
+type changeRow struct {
+	Seq        int64     `db:"seq"`
+	ChangeType string    `db:"change_type"`
+	Task       []byte    `db:"task"`
+	ChangedAt  time.Time `db:"changed_at"`
+}
+
+// event decodes the task snapshot stored with the change
+func (c *changeRow) event() (models.TaskEvent, error) {
+	var task models.Task
+	if err := json.Unmarshal(c.Task, &task); err != nil {
+		return models.TaskEvent{}, fmt.Errorf("failed to decode change %d: %w", c.Seq, err)
+	}
+	return models.TaskEvent{Seq: c.Seq, Type: c.ChangeType, Task: &task, OccurredAt: c.ChangedAt}, nil
+}
+
+// ListenForChanges relays changes announced by the tasks trigger to WatchTasks callers
+// until ctx is done. Seqs are taken at commit, so notifications arrive in seq order;
+// after a reconnect or a failed load the listener catches up on every change since the
+// last one it relayed.
+func (r *TaskRepository) ListenForChanges(ctx context.Context) error {
+	listener := pq.NewListener(r.db.connStr, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Change listener: %v", err)
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(changeChannel); err != nil {
+		return wrapError(ctx, "listen for task changes", err)
+	}
+
+	// last is the highest seq relayed so far, the starting point for catching up
+	var last int64
+	if err := r.db.GetContext(ctx, &last, `SELECT COALESCE(max(seq), 0) FROM task_changes`); err != nil {
+		return wrapError(ctx, "read latest task change", err)
+	}
+
+	ping := time.NewTicker(listenerPingInterval)
+	defer ping.Stop()
+
+	// behind is set when notifications may have been missed, after a reconnect or a
+	// failed load, so the next round reloads everything after last instead of the
+	// notified seqs; retry schedules that round when no notification prompts it
+	behind := false
+	var retry <-chan time.Time
+
+	for {
+		var seqs []int64
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ping.C:
+			go listener.Ping()
+			continue
+		case <-retry:
+		case n := <-listener.Notify:
+			// A nil notification means the connection was re-established
+			if n == nil {
+				behind = true
+			} else {
+				seqs = append(seqs, parseSeq(n.Extra))
+			}
+		}
+
+		// Drain whatever else is queued so a burst costs one query
+	drain:
+		for {
+			select {
+			case n := <-listener.Notify:
+				if n == nil {
+					behind = true
+				} else {
+					seqs = append(seqs, parseSeq(n.Extra))
+				}
+			default:
+				break drain
+			}
+		}
+
+		var events []models.TaskEvent
+		var err error
+		if behind {
+			events, err = r.changesSince(ctx, last)
+		} else {
+			events, err = r.changesBySeq(ctx, seqs)
+		}
+		if err != nil {
+			log.Printf("Failed to load task changes after seq %d: %v", last, err)
+			behind, retry = true, time.After(time.Second)
+			continue
+		}
+		behind, retry = false, nil
+
+		for _, ev := range events {
+			// Notifications for changes a catch-up already relayed arrive afterwards
+			if ev.Seq <= last {
+				continue
+			}
+			r.changes.publish(ev)
+			last = ev.Seq
+		}
+	}
+}
+
+// WatchTasks streams changes relayed by ListenForChanges, resuming from the task_changes table
+func (r *TaskRepository) WatchTasks(ctx context.Context, seq int64, fn func(models.TaskEvent) error) error {
+	return r.changes.watch(ctx, seq, func(seq int64) ([]models.TaskEvent, error) {
+		var bounds struct {
+			First int64 `db:"first"`
+			Last  int64 `db:"last"`
+		}
+		query := `SELECT COALESCE(min(seq), 0) AS first, COALESCE(max(seq), 0) AS last FROM task_changes`
+		if err := r.db.GetContext(ctx, &bounds, query); err != nil {
+			return nil, wrapError(ctx, "read task change bounds", err)
+		}
+		if seq > bounds.Last || seq < bounds.First-1 {
+			return nil, fmt.Errorf("changes after %d: %w", seq, ErrExpired)
+		}
+		return r.changesSince(ctx, seq)
+	}, fn)
+}
+
+// PruneTaskChanges deletes changes recorded before cutoff, always keeping the latest
+// so resuming watchers can tell an expired cursor from a quiet feed
+func (r *TaskRepository) PruneTaskChanges(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+    DELETE FROM task_changes
+    WHERE changed_at < $1 AND seq < (SELECT max(seq) FROM task_changes)`
+
+	result, err := r.db.ExecContext(ctx, query, cutoff)
+	if err != nil {
+		return 0, wrapError(ctx, "prune task changes", err)
+	}
+
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return pruned, nil
+}
+
+// changesSince loads the changes recorded after seq in seq order
+func (r *TaskRepository) changesSince(ctx context.Context, seq int64) ([]models.TaskEvent, error) {
+	var rows []changeRow
+	query := `SELECT ` + changeColumns + ` FROM task_changes WHERE seq > $1 ORDER BY seq`
+	if err := r.db.SelectContext(ctx, &rows, query, seq); err != nil {
+		return nil, wrapError(ctx, "load task changes", err)
+	}
+	return changeEvents(rows, nil)
+}
+
+// changesBySeq loads the given changes, keeping the order of seqs
+func (r *TaskRepository) changesBySeq(ctx context.Context, seqs []int64) ([]models.TaskEvent, error) {
+	var rows []changeRow
+	query := `SELECT ` + changeColumns + ` FROM task_changes WHERE seq = ANY($1)`
+	if err := r.db.SelectContext(ctx, &rows, query, pq.Array(seqs)); err != nil {
+		return nil, wrapError(ctx, "load task changes", err)
+	}
+	return changeEvents(rows, seqs)
+}
+
+// changeEvents decodes rows, reordering them to match seqs when it is given
+func changeEvents(rows []changeRow, seqs []int64) ([]models.TaskEvent, error) {
+	bySeq := make(map[int64]models.TaskEvent, len(rows))
+	events := make([]models.TaskEvent, 0, len(rows))
+	for i := range rows {
+		ev, err := rows[i].event()
+		if err != nil {
+			return nil, err
+		}
+		if seqs == nil {
+			events = append(events, ev)
+		} else {
+			bySeq[ev.Seq] = ev
+		}
+	}
+	for _, seq := range seqs {
+		if ev, ok := bySeq[seq]; ok {
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+// parseSeq reads the seq carried by a change notification; malformed payloads yield 0
+func parseSeq(payload string) int64 {
+	seq, _ := strconv.ParseInt(payload, 10, 64)
+	return seq
+}
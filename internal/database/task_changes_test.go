@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+func TestChangesFollowCommitOrder(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewTaskRepository(db, testWorkflow(t))
+	ctx := context.Background()
+
+	first, second := newTestTask("first"), newTestTask("second")
+	for _, task := range []*models.Task{first, second} {
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+	var start int64
+	if err := db.GetContext(ctx, &start, `SELECT COALESCE(max(seq), 0) FROM task_changes`); err != nil {
+		t.Fatalf("read latest seq: %v", err)
+	}
+
+	// The first transaction writes before the second but commits after it
+	tx1, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer tx1.Rollback()
+	if _, err := tx1.ExecContext(ctx, `UPDATE tasks SET title = 'first updated' WHERE id = $1`, first.ID); err != nil {
+		t.Fatalf("update first: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE tasks SET title = 'second updated' WHERE id = $1`, second.ID); err != nil {
+		t.Fatalf("update second: %v", err)
+	}
+
+	// A watcher sees the second change and resumes from it
+	delivered := changesOf(t, repo, start, first.ID, second.ID)
+	if len(delivered) != 1 || delivered[0].Task.ID != second.ID {
+		t.Fatalf("before the first commit got %v, want only the second task's change", delivered)
+	}
+	if err := tx1.Commit(); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	resumed := changesOf(t, repo, delivered[0].Seq, first.ID, second.ID)
+	if len(resumed) != 1 || resumed[0].Task.ID != first.ID {
+		t.Fatalf("resuming after seq %d got %v, want the first task's change", delivered[0].Seq, resumed)
+	}
+}
+
+// changesOf returns the changes after seq to the tasks with the given IDs
+func changesOf(t *testing.T, repo *TaskRepository, seq int64, ids ...string) []models.TaskEvent {
+	t.Helper()
+	events, err := repo.changesSince(context.Background(), seq)
+	if err != nil {
+		t.Fatalf("changesSince(%d): %v", seq, err)
+	}
+	var matched []models.TaskEvent
+	for _, ev := range events {
+		if slices.Contains(ids, ev.Task.ID) {
+			matched = append(matched, ev)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+)
+
+// historyRow is one row of the task_events table
+type historyRow struct {
+	ID         int64     `db:"id"`
+	TaskID     string    `db:"task_id"`
+	EventType  string    `db:"event_type"`
+	Actor      string    `db:"actor"`
+	RequestID  string    `db:"request_id"`
+	Changes    []byte    `db:"changes"`
+	Version    int64     `db:"version"`
+	OccurredAt time.Time `db:"occurred_at"`
+}
+
+// ListTaskHistory lists a task's history entries, newest first
+func (r *TaskRepository) ListTaskHistory(ctx context.Context, req *models.ListTaskHistoryRequest) (*models.ListTaskHistoryResponse, error) {
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	query := `
+    SELECT id, task_id, event_type, actor, request_id, changes, version, occurred_at
+    FROM task_events WHERE task_id = $1`
+	args := []interface{}{req.TaskID}
+	if req.Cursor != nil {
+		query += ` AND id < $2`
+		args = append(args, req.Cursor.ID)
+	}
+	query += fmt.Sprintf(` ORDER BY id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize+1)
+
+	var rows []historyRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, wrapError(ctx, "list task history", err)
+	}
+
+	entries := make([]*models.TaskHistoryEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = &models.TaskHistoryEntry{
+			ID:         row.ID,
+			TaskID:     row.TaskID,
+			Type:       row.EventType,
+			Actor:      row.Actor,
+			RequestID:  row.RequestID,
+			Version:    row.Version,
+			OccurredAt: row.OccurredAt,
+		}
+		if err := json.Unmarshal(row.Changes, &entries[i].Changes); err != nil {
+			return nil, fmt.Errorf("failed to decode history entry %d: %w", row.ID, err)
+		}
+	}
+
+	return models.NewListTaskHistoryResponse(entries, pageSize), nil
+}
+
+// historyEntry describes a change made on behalf of the actor and request in ctx
+func historyEntry(ctx context.Context, kind string, before, after *models.Task) *models.TaskHistoryEntry {
+	return models.NewTaskHistoryEntry(kind, audit.ActorFrom(ctx), audit.RequestIDFrom(ctx), before, after)
+}
+
+// recordHistory inserts entries into task_events with one multi-row INSERT. Callers
+// pass at most insertChunkSize entries and run it in the mutation's transaction.
+func recordHistory(ctx context.Context, tx sqlx.ExecerContext, entries ...*models.TaskHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(entries))
+	args := make([]interface{}, 0, len(entries)*6)
+	for i, entry := range entries {
+		changes, err := json.Marshal(entry.Changes)
+		if err != nil {
+			return fmt.Errorf("failed to encode history for task %s: %w", entry.TaskID, err)
+		}
+		n := len(args)
+		values[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d::jsonb, $%d)", n+1, n+2, n+3, n+4, n+5, n+6)
+		args = append(args, entry.TaskID, entry.Type, entry.Actor, entry.RequestID, string(changes), entry.Version)
+	}
+
+	query := `
+    INSERT INTO task_events (task_id, event_type, actor, request_id, changes, version)
+    VALUES ` + strings.Join(values, ", ")
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return wrapError(ctx, "record task history", err)
+	}
+	return nil
+}
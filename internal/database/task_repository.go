@@ -5,177 +5,789 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/Samarth11-A/TaskListAPI/internal/audit"
+	"github.com/Samarth11-A/TaskListAPI/internal/filter"
 	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/rank"
+	"github.com/Samarth11-A/TaskListAPI/internal/search"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
-// TaskRepository provides methods to interact with tasks in the database
+// filterColumns maps models.TaskSchema fields to SQL expressions
+var filterColumns = map[string]string{
+	"id":          "id",
+	"title":       "title",
+	"description": "COALESCE(description, '')",
+	"completed":   "completed",
+	"status":      "status",
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"version":     "version",
+	"priority":    "priority",
+	"project_id":  "project_id",
+	"parent_id":   "COALESCE(parent_id, '')",
+	"recurrence":  "recurrence",
+	"series_id":   "series_id",
+}
+
+// taskColumns is the column list scanned into models.Task, with the task's labels and blockers
+const taskColumns = `id, title, COALESCE(description, '') AS description, completed, status, completed_at,
+    created_at, updated_at, version, deleted_at, due_at, remind_at, reminded_at, priority, position, project_id,
+    COALESCE(parent_id, '') AS parent_id, recurrence, series_id, occurrence, task_label_list(id) AS labels,
+    task_blocker_list(id) AS blocked_by, task_blocked(id) AS blocked`
+
+// insertColumns are the columns a new task is inserted with, in the order of insertArgs
+var insertColumns = []string{"id", "title", "description", "completed", "status", "completed_at", "created_at",
+	"updated_at", "version", "due_at", "remind_at", "priority", "position", "project_id", "parent_id", "recurrence",
+	"series_id", "occurrence"}
+
+// insertArgs returns the values of insertColumns for task
+func insertArgs(task *models.Task) []interface{} {
+	// Top-level tasks store NULL, which the foreign key ignores
+	parentID := sql.NullString{String: task.ParentID, Valid: task.ParentID != ""}
+	return []interface{}{task.ID, task.Title, task.Description, task.Completed, task.Status, task.CompletedAt,
+		task.CreatedAt, task.UpdatedAt, task.Version, task.DueAt, task.RemindAt, task.Priority, task.Position, task.ProjectID, parentID,
+		task.Recurrence, task.SeriesID, task.Occurrence}
+}
+
+// insertRow returns the VALUES row for insertColumns bound to the parameters after the first n
+func insertRow(n int) string {
+	params := make([]string, len(insertColumns))
+	for i := range params {
+		params[i] = fmt.Sprintf("$%d", n+i+1)
+	}
+	return "(" + strings.Join(params, ", ") + ")"
+}
+
+// deleteTaskQuery moves task $1 to the trash; callers check its version under lockTask
+const deleteTaskQuery = `
+    UPDATE tasks
+    SET deleted_at = now(), version = version + 1
+    WHERE id = $1 AND deleted_at IS NULL`
+
+// insertChunkSize bounds the rows per multi-row INSERT in BatchCreateTasks,
+// keeping the statement well under PostgreSQL's 65535 parameter limit
+const insertChunkSize = 500
+
+// TaskRepository is the PostgreSQL implementation of TaskStore
 type TaskRepository struct {
-	db *PostgresDB
+	db       *PostgresDB
+	changes  *changeFeed // fed by ListenForChanges
+	workflow *models.Workflow
 }
 
-// NewTaskRepository creates a new task repository
-func NewTaskRepository(db *PostgresDB) *TaskRepository {
-	return &TaskRepository{db: db}
+// NewTaskRepository creates a new task repository whose tasks follow workflow
+func NewTaskRepository(db *PostgresDB, workflow *models.Workflow) *TaskRepository {
+	return &TaskRepository{db: db, changes: newChangeFeed(0), workflow: workflow}
 }
 
 // CreateTask adds a new task to the database
 func (r *TaskRepository) CreateTask(ctx context.Context, task *models.Task) error {
-	query := `
-    INSERT INTO tasks (id, title, description, completed, created_at, updated_at)
-    VALUES ($1, $2, $3, $4, $5, $6)`
+	query := `INSERT INTO tasks (` + strings.Join(insertColumns, ", ") + `) VALUES ` + insertRow(0)
 
-	_, err := r.db.ExecContext(ctx, query,
-		task.ID, task.Title, task.Description, task.Completed,
-		task.CreatedAt.Format(time.RFC3339), task.UpdatedAt.Format(time.RFC3339))
-
-	if err != nil {
-		return fmt.Errorf("failed to create task: %w", err)
+	truncateTimes(task)
+	if task.Version == 0 {
+		task.Version = 1
 	}
+	task.StartSeries()
+	r.workflow.Settle(nil, task)
+
+	return r.inTx(ctx, func(tx *sqlx.Tx) error {
+		if task.ParentID != "" {
+			parents, err := lockParents(ctx, tx, []string{task.ParentID})
+			if err != nil {
+				return err
+			}
+			project, ok := parents[task.ParentID]
+			if !ok {
+				return fmt.Errorf("task with ID %s: %w", task.ParentID, ErrParentNotFound)
+			}
+			if task.ProjectID == "" {
+				task.ProjectID = project
+			}
+		}
+		if task.ProjectID == "" {
+			task.ProjectID = models.DefaultProjectID
+		}
 
-	return nil
+		if err := lockProject(ctx, tx, task.ProjectID); err != nil {
+			return err
+		}
+		if task.Position == "" {
+			last, err := lastPosition(ctx, tx, task.ProjectID, "")
+			if err != nil {
+				return err
+			}
+			if task.Position, err = rank.Between(last, ""); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, query, insertArgs(task)...)
+
+		if err != nil {
+			return wrapError(ctx, "create task "+task.ID, err)
+		}
+
+		return recordChanges(ctx, tx, taskChange{kind: models.ChangeCreated, after: task})
+	})
 }
 
 // GetTask retrieves a task by ID
 func (r *TaskRepository) GetTask(ctx context.Context, id string) (*models.Task, error) {
-	query := `SELECT id, title, description, completed, created_at, updated_at FROM tasks WHERE id = $1`
-
-	var task struct {
-		ID          string `db:"id"`
-		Title       string `db:"title"`
-		Description string `db:"description"`
-		Completed   bool   `db:"completed"`
-		CreatedAt   string `db:"created_at"`
-		UpdatedAt   string `db:"updated_at"`
-	}
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1 AND deleted_at IS NULL`
 
+	var task models.Task
 	err := r.db.GetContext(ctx, &task, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("task not found with ID: %s", id)
+			return nil, fmt.Errorf("task with ID %s: %w", id, ErrNotFound)
+		}
+		return nil, wrapError(ctx, "get task", err)
+	}
+
+	return &task, nil
+}
+
+// ListTasks retrieves filtered tasks with keyset pagination in the requested order
+func (r *TaskRepository) ListTasks(ctx context.Context, req *models.ListTasksRequest) (*models.ListTasksResponse, error) {
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	where := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	f := req.Filter
+	if f.ProjectID != "" {
+		where = append(where, "project_id = "+arg(f.ProjectID))
+	}
+	if f.ParentID != "" {
+		where = append(where, "parent_id = "+arg(f.ParentID))
+	}
+	if f.Completed != nil {
+		where = append(where, "completed = "+arg(*f.Completed))
+	}
+	if !f.CreatedAfter.IsZero() {
+		where = append(where, "created_at >= "+arg(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		where = append(where, "created_at < "+arg(f.CreatedBefore))
+	}
+	if !f.UpdatedAfter.IsZero() {
+		where = append(where, "updated_at >= "+arg(f.UpdatedAfter))
+	}
+	if !f.UpdatedBefore.IsZero() {
+		where = append(where, "updated_at < "+arg(f.UpdatedBefore))
+	}
+	if f.TitleContains != "" {
+		where = append(where, "strpos(lower(title), lower("+arg(f.TitleContains)+")) > 0")
+	}
+	if !f.DueAfter.IsZero() {
+		where = append(where, "due_at >= "+arg(f.DueAfter))
+	}
+	if !f.DueBefore.IsZero() {
+		where = append(where, "due_at < "+arg(f.DueBefore))
+	}
+	if f.Overdue {
+		where = append(where, "due_at < "+arg(f.Now)+" AND NOT completed")
+	}
+	if f.DueWithin > 0 {
+		where = append(where, "due_at >= "+arg(f.Now)+" AND due_at < "+arg(f.Now.Add(f.DueWithin)))
+	}
+	// Label filters start from task_labels_label_id_task_id_idx, so selective labels stay cheap
+	if len(f.LabelsAny) > 0 {
+		where = append(where, "id IN (SELECT task_id FROM task_labels WHERE label_id = ANY("+arg(pq.Array(f.LabelsAny))+"))")
+	}
+	if len(f.LabelsAll) > 0 {
+		ids := slices.Compact(slices.Sorted(slices.Values(f.LabelsAll)))
+		where = append(where, fmt.Sprintf(
+			"id IN (SELECT task_id FROM task_labels WHERE label_id = ANY(%s) GROUP BY task_id HAVING count(*) = %d)",
+			arg(pq.Array(ids)), len(ids)))
+	}
+
+	if req.Expr != nil {
+		cond, err := filter.ToSQL(req.Expr, filterColumns, arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate filter: %w: %w", ErrInvalid, err)
 		}
-		return nil, fmt.Errorf("failed to get task: %w", err)
+		where = append(where, cond)
+	}
+
+	// The sort column is one of the whitelisted models.OrderBy* names
+	order := req.Order()
+	cmp, dir := ">", "ASC"
+	if order.Desc {
+		cmp, dir = "<", "DESC"
+	}
+	if req.Cursor != nil {
+		where = append(where, fmt.Sprintf("(%s, id) %s (%s, %s)",
+			order.Field, cmp, arg(req.Cursor.Value(order.Field)), arg(req.Cursor.ID)))
 	}
 
-	// Parse timestamps
-	createdAt, err := time.Parse(time.RFC3339, task.CreatedAt)
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE ` + strings.Join(where, " AND ")
+
+	// Fetch one extra row to learn whether another page follows
+	query += fmt.Sprintf(` ORDER BY %s %s, id %s LIMIT %s`, order.Field, dir, dir, arg(pageSize+1))
+
+	var tasks []*models.Task
+	err := r.db.SelectContext(ctx, &tasks, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse created_at: %w", err)
+		return nil, wrapError(ctx, "list tasks", err)
 	}
 
-	updatedAt, err := time.Parse(time.RFC3339, task.UpdatedAt)
+	return models.NewListTasksResponse(tasks, pageSize), nil
+}
+
+// SearchTasks runs a ranked full-text query against the search_vector column
+func (r *TaskRepository) SearchTasks(ctx context.Context, req *models.SearchTasksRequest) (*models.SearchTasksResponse, error) {
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	args := []interface{}{req.Query}
+	after := ""
+	if req.Cursor != nil {
+		after = `WHERE (rank, id) < ($2::real, $3)`
+		args = append(args, req.Cursor.Rank, req.Cursor.ID)
+	}
+	args = append(args, pageSize+1)
+
+	// Snippets are computed in the outer query so only returned rows pay for ts_headline
+	query := fmt.Sprintf(`
+    SELECT %s, rank,
+        ts_headline('english', title, q, 'HighlightAll=true') AS title_snippet,
+        ts_headline('english', COALESCE(description, ''), q, 'MaxWords=%d') AS description_snippet
+    FROM (
+        SELECT t.*, ts_rank_cd(t.search_vector, q) AS rank, q
+        FROM tasks t, websearch_to_tsquery('english', $1) q
+        WHERE t.search_vector @@ q AND t.deleted_at IS NULL
+    ) matches
+    %s
+    ORDER BY rank DESC, id DESC
+    LIMIT $%d`, taskColumns, search.MaxSnippetWords, after, len(args))
+
+	var rows []struct {
+		models.Task
+		Rank               float64 `db:"rank"`
+		TitleSnippet       string  `db:"title_snippet"`
+		DescriptionSnippet string  `db:"description_snippet"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, wrapError(ctx, "search tasks", err)
+	}
+
+	results := make([]*models.SearchResult, len(rows))
+	for i := range rows {
+		task := rows[i].Task
+		results[i] = &models.SearchResult{
+			Task:               &task,
+			Rank:               rows[i].Rank,
+			TitleSnippet:       rows[i].TitleSnippet,
+			DescriptionSnippet: rows[i].DescriptionSnippet,
+		}
+	}
+
+	return models.NewSearchTasksResponse(results, pageSize), nil
+}
+
+// UpdateTask updates the listed columns of an existing task if its version matches
+func (r *TaskRepository) UpdateTask(ctx context.Context, task *models.Task, fields []string, ignoreBlockers bool) error {
+	truncateTimes(task)
+
+	return r.inTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockTask(ctx, tx, task.ID, false, task.Version)
+		if err != nil {
+			return err
+		}
+		r.workflow.Settle(before, task)
+		if err := checkBlocked(before, task, fields, ignoreBlockers); err != nil {
+			return err
+		}
+		if updatesParent(fields) && task.ParentID != before.ParentID {
+			if err := checkParent(ctx, tx, task.ID, task.ParentID); err != nil {
+				return err
+			}
+		}
+
+		query, args, err := updateStatement(task, fields)
+		if err != nil {
+			return err
+		}
+		after, err := applyChange(ctx, tx, models.ChangeUpdated, before, query, args...)
+		if err != nil {
+			return err
+		}
+		if err := r.createNextOccurrence(ctx, tx, before, after); err != nil {
+			return err
+		}
+
+		task.Version = after.Version
+		return nil
+	})
+}
+
+// DeleteTask moves a task to the trash if its version matches
+func (r *TaskRepository) DeleteTask(ctx context.Context, id string, version int64) error {
+	return r.inTx(ctx, func(tx *sqlx.Tx) error {
+		_, err := deleteTask(ctx, tx, id, version)
+		return err
+	})
+}
+
+// RestoreTask moves a task out of the trash if its version matches
+func (r *TaskRepository) RestoreTask(ctx context.Context, id string, version int64) (*models.Task, error) {
+	query := `
+    UPDATE tasks
+    SET deleted_at = NULL, version = version + 1
+    WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	var task *models.Task
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockTask(ctx, tx, id, true, version)
+		if err != nil {
+			return err
+		}
+
+		task, err = applyChange(ctx, tx, models.ChangeRestored, before, query, id)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse updated_at: %w", err)
+		return nil, err
 	}
 
-	return &models.Task{
-		ID:          task.ID,
-		Title:       task.Title,
-		Description: task.Description,
-		Completed:   task.Completed,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
-	}, nil
+	return task, nil
 }
 
-// ListTasks retrieves tasks with pagination support
-func (r *TaskRepository) ListTasks(ctx context.Context, req *models.ListTasksRequest) (*models.ListTasksResponse, error) {
-	// Set default page size if not specified
-	pageSize := req.PageSize
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 10
+// ListDeletedTasks lists trashed tasks, most recently deleted first
+func (r *TaskRepository) ListDeletedTasks(ctx context.Context, req *models.ListDeletedTasksRequest) (*models.ListDeletedTasksResponse, error) {
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE deleted_at IS NOT NULL`
+	args := []interface{}{}
+	if req.Cursor != nil {
+		query += ` AND (deleted_at, id) < ($1, $2)`
+		args = append(args, req.Cursor.DeletedAt, req.Cursor.ID)
 	}
+	query += fmt.Sprintf(` ORDER BY deleted_at DESC, id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize+1)
 
-	// For simplicity, we'll just return all tasks for now
-	// In a real implementation, you'd handle pagination properly
-	query := `SELECT id, title, description, completed, created_at, updated_at FROM tasks ORDER BY created_at DESC LIMIT $1`
+	var tasks []*models.Task
+	if err := r.db.SelectContext(ctx, &tasks, query, args...); err != nil {
+		return nil, wrapError(ctx, "list deleted tasks", err)
+	}
+
+	return models.NewListDeletedTasksResponse(tasks, pageSize), nil
+}
 
-	var dbTasks []struct {
-		ID          string `db:"id"`
-		Title       string `db:"title"`
-		Description string `db:"description"`
-		Completed   bool   `db:"completed"`
-		CreatedAt   string `db:"created_at"`
-		UpdatedAt   string `db:"updated_at"`
+// PurgeDeletedTasks permanently removes tasks deleted before cutoff, recording each in
+// its history, the outbox and webhook deliveries
+func (r *TaskRepository) PurgeDeletedTasks(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+    WITH purged AS (
+        DELETE FROM tasks WHERE deleted_at < $1 RETURNING id, version
+    ), events AS (
+        SELECT id, jsonb_build_object(
+            'type', $2::text, 'task_id', id, 'changes', '[]'::jsonb, 'actor', $3::text,
+            'request_id', $4::text, 'version', version, 'occurred_at', now()) AS payload
+        FROM purged
+    ), history AS (
+        INSERT INTO task_events (task_id, event_type, actor, request_id, version)
+        SELECT id, $2, $3, $4, version FROM purged
+    ), hooks AS (
+        INSERT INTO webhook_deliveries (webhook_id, event_type, task_id, payload)
+        SELECT w.id, $5, e.id, e.payload
+        FROM events e JOIN webhooks w ON w.disabled_at IS NULL
+            AND (cardinality(w.event_types) = 0 OR $5 = ANY (w.event_types))
+    )
+    INSERT INTO outbox (topic, aggregate_id, payload)
+    SELECT $5, id, payload FROM events`
+
+	result, err := r.db.ExecContext(ctx, query, cutoff, models.ChangePurged,
+		audit.ActorFrom(ctx), audit.RequestIDFrom(ctx), outboxTopicPrefix+models.ChangePurged)
+	if err != nil {
+		return 0, wrapError(ctx, "purge deleted tasks", err)
 	}
 
-	err := r.db.SelectContext(ctx, &dbTasks, query, pageSize)
+	purged, err := result.RowsAffected()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tasks: %w", err)
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return purged, nil
+}
+
+// BatchCreateTasks inserts tasks with multi-row INSERTs in one transaction.
+// IDs that already exist, missing projects and missing parents are the only per-item
+// failures; anything else fails the batch. A parent may be created earlier in the batch.
+func (r *TaskRepository) BatchCreateTasks(ctx context.Context, tasks []*models.Task, atomic bool) ([]models.BatchResult, error) {
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ParentID
 	}
 
-	tasks := make([]*models.Task, len(dbTasks))
-	for i, dbTask := range dbTasks {
-		createdAt, err := time.Parse(time.RFC3339, dbTask.CreatedAt)
+	results := make([]models.BatchResult, len(tasks))
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		parents, err := lockParents(ctx, tx, ids)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse created_at: %w", err)
+			return err
 		}
 
-		updatedAt, err := time.Parse(time.RFC3339, dbTask.UpdatedAt)
+		// Subtasks without a project inherit their parent's, which may be in the batch
+		projectOf := maps.Clone(parents)
+		for i, task := range tasks {
+			if task.ProjectID == "" {
+				task.ProjectID = projectOf[task.ParentID]
+			}
+			if task.ProjectID == "" {
+				task.ProjectID = models.DefaultProjectID
+			}
+			projectOf[task.ID] = task.ProjectID
+			ids[i] = task.ProjectID
+		}
+
+		projects, err := lockProjects(ctx, tx, ids)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse updated_at: %w", err)
+			return err
+		}
+
+		// New tasks go to the end of their project's manual order, in batch order
+		positions := make(map[string]string, len(projects))
+		for _, project := range projects {
+			if positions[project], err = lastPosition(ctx, tx, project, ""); err != nil {
+				return err
+			}
 		}
 
-		tasks[i] = &models.Task{
-			ID:          dbTask.ID,
-			Title:       dbTask.Title,
-			Description: dbTask.Description,
-			Completed:   dbTask.Completed,
-			CreatedAt:   createdAt,
-			UpdatedAt:   updatedAt,
+		for start := 0; start < len(tasks); start += insertChunkSize {
+			chunk := tasks[start:min(start+insertChunkSize, len(tasks))]
+
+			values := make([]string, 0, len(chunk))
+			args := make([]interface{}, 0, len(chunk)*len(insertColumns))
+			for i, task := range chunk {
+				var err error
+				if _, ok := parents[task.ParentID]; task.ParentID != "" && !ok {
+					err = fmt.Errorf("task with ID %s: %w", task.ParentID, ErrParentNotFound)
+				} else if !slices.Contains(projects, task.ProjectID) {
+					err = fmt.Errorf("project with ID %s: %w", task.ProjectID, ErrProjectNotFound)
+				}
+				if err != nil {
+					if atomic {
+						return fmt.Errorf("item %d: %w", start+i, err)
+					}
+					results[start+i].Err = err
+					continue
+				}
+				parents[task.ID] = task.ProjectID
+
+				truncateTimes(task)
+				if task.Version == 0 {
+					task.Version = 1
+				}
+				task.StartSeries()
+				r.workflow.Settle(nil, task)
+				if task.Position == "" {
+					position, err := rank.Between(positions[task.ProjectID], "")
+					if err != nil {
+						return err
+					}
+					task.Position, positions[task.ProjectID] = position, position
+				}
+				values = append(values, insertRow(len(args)))
+				args = append(args, insertArgs(task)...)
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			query := `
+    INSERT INTO tasks (` + strings.Join(insertColumns, ", ") + `)
+    VALUES ` + strings.Join(values, ", ") + `
+    ON CONFLICT (id) DO NOTHING
+    RETURNING id`
+
+			var ids []string
+			if err := tx.SelectContext(ctx, &ids, query, args...); err != nil {
+				return wrapError(ctx, "batch create tasks", err)
+			}
+
+			// Rows skipped by ON CONFLICT are missing from RETURNING. A repeated ID
+			// within the batch is inserted once and reported as existing after that.
+			inserted := make(map[string]bool, len(ids))
+			for _, id := range ids {
+				inserted[id] = true
+			}
+			var changes []taskChange
+			for i, task := range chunk {
+				if results[start+i].Err != nil {
+					continue
+				}
+				if inserted[task.ID] {
+					delete(inserted, task.ID)
+					results[start+i].Task = task
+					changes = append(changes, taskChange{kind: models.ChangeCreated, after: task})
+					continue
+				}
+				err := fmt.Errorf("failed to create task %s: %w", task.ID, ErrAlreadyExists)
+				if atomic {
+					return fmt.Errorf("item %d: %w", start+i, err)
+				}
+				results[start+i].Err = err
+			}
+
+			if err := recordChanges(ctx, tx, changes...); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return results, nil
+}
+
+// BatchUpdateTasks applies each update request in one transaction, locking the
+// affected rows up front in ID order so concurrent batches cannot deadlock.
+// Missing tasks and version conflicts are per-item failures; anything else fails the batch.
+func (r *TaskRepository) BatchUpdateTasks(ctx context.Context, reqs []*models.UpdateTaskRequest, atomic bool) ([]models.BatchResult, error) {
+	ids := make([]string, len(reqs))
+	for i, req := range reqs {
+		ids[i] = req.ID
+	}
+	now := time.Now().Truncate(time.Microsecond)
+
+	return r.batch(ctx, ids, atomic, func(tx *sqlx.Tx, i int) (*models.Task, error) {
+		req := reqs[i]
+
+		before, err := lockTask(ctx, tx, req.ID, false, req.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		task := *before
+		req.ApplyTo(&task)
+		task.UpdatedAt = now
+		truncateTimes(&task)
+		r.workflow.Settle(before, &task)
+		if err := checkBlocked(before, &task, req.Fields(), req.IgnoreBlockers); err != nil {
+			return nil, err
+		}
+		if updatesParent(req.Fields()) && task.ParentID != before.ParentID {
+			if err := checkParent(ctx, tx, task.ID, task.ParentID); err != nil {
+				return nil, err
+			}
+		}
 
-	return &models.ListTasksResponse{
-		Tasks:         tasks,
-		NextPageToken: "", // Implement proper pagination tokens if needed
-	}, nil
+		query, args, err := updateStatement(&task, req.Fields())
+		if err != nil {
+			return nil, err
+		}
+		after, err := applyChange(ctx, tx, models.ChangeUpdated, before, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.createNextOccurrence(ctx, tx, before, after); err != nil {
+			return nil, err
+		}
+		return after, nil
+	})
 }
 
-// UpdateTask updates an existing task
-func (r *TaskRepository) UpdateTask(ctx context.Context, task *models.Task) error {
-	query := `
-    UPDATE tasks 
-    SET title = $2, description = $3, completed = $4, updated_at = $5
-    WHERE id = $1`
+// BatchDeleteTasks moves each requested task to the trash in one transaction.
+// Missing tasks and version conflicts are per-item failures; anything else fails the batch.
+func (r *TaskRepository) BatchDeleteTasks(ctx context.Context, reqs []*models.DeleteTaskRequest, atomic bool) ([]models.BatchResult, error) {
+	ids := make([]string, len(reqs))
+	for i, req := range reqs {
+		ids[i] = req.ID
+	}
 
-	result, err := r.db.ExecContext(ctx, query,
-		task.ID, task.Title, task.Description, task.Completed, task.UpdatedAt.Format(time.RFC3339))
+	return r.batch(ctx, ids, atomic, func(tx *sqlx.Tx, i int) (*models.Task, error) {
+		_, err := deleteTask(ctx, tx, reqs[i].ID, reqs[i].Version)
+		return nil, err
+	})
+}
 
+// batch locks the rows with the given IDs, then runs item for each of them in one
+// transaction. Outside atomic mode each item runs in its own savepoint, so a failed
+// item leaves nothing behind, even when the database rejected one of its statements
+// and would otherwise abort the whole transaction; errors from the store itself abort
+// the batch in either mode.
+func (r *TaskRepository) batch(ctx context.Context, ids []string, atomic bool, item func(tx *sqlx.Tx, i int) (*models.Task, error)) ([]models.BatchResult, error) {
+	results := make([]models.BatchResult, len(ids))
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		lock := `SELECT id FROM tasks WHERE id = ANY($1) ORDER BY id FOR UPDATE`
+		var locked []string
+		if err := tx.SelectContext(ctx, &locked, lock, pq.Array(ids)); err != nil {
+			return wrapError(ctx, "lock tasks", err)
+		}
+
+		for i := range ids {
+			if atomic {
+				task, err := item(tx, i)
+				if err != nil {
+					return fmt.Errorf("item %d: %w", i, err)
+				}
+				results[i] = models.BatchResult{Task: task}
+				continue
+			}
+
+			if _, err := tx.ExecContext(ctx, `SAVEPOINT batch_item`); err != nil {
+				return wrapError(ctx, "start batch item", err)
+			}
+			task, err := item(tx, i)
+			if err != nil {
+				if !isItemError(err) {
+					return fmt.Errorf("item %d: %w", i, err)
+				}
+				if _, rerr := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT batch_item`); rerr != nil {
+					return wrapError(ctx, "undo batch item", rerr)
+				}
+			} else if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT batch_item`); err != nil {
+				return wrapError(ctx, "finish batch item", err)
+			}
+			results[i] = models.BatchResult{Task: task, Err: err}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+		return nil, err
 	}
+	return results, nil
+}
 
-	rowsAffected, err := result.RowsAffected()
+// inTx runs fn in a transaction, committing if it succeeds and rolling back otherwise
+func (r *TaskRepository) inTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return wrapError(ctx, "begin transaction", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
 	}
+	if err := tx.Commit(); err != nil {
+		return wrapError(ctx, "commit transaction", err)
+	}
+	return nil
+}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("task not found with ID: %s", task.ID)
+// isItemError reports whether err concerns a single batch item rather than the store
+func isItemError(err error) bool {
+	return errors.Is(err, ErrNotFound) || errors.Is(err, ErrConflict) || errors.Is(err, ErrAlreadyExists) ||
+		errors.Is(err, ErrParentNotFound) || errors.Is(err, ErrTaskCycle) || errors.Is(err, ErrTaskBlocked)
+}
+
+// updateStatement builds the guarded UPDATE for the listed fields of task
+// (all when fields is nil). Column names come from a fixed whitelist; values are
+// always parameters.
+func updateStatement(task *models.Task, fields []string) (string, []interface{}, error) {
+	if fields == nil {
+		fields = models.UpdatableFields
 	}
 
-	return nil
+	args := []interface{}{task.ID, task.Version, task.UpdatedAt}
+	set := "updated_at = $3, version = version + 1"
+	for _, field := range fields {
+		var value interface{}
+		switch field {
+		case models.FieldTitle:
+			value = task.Title
+		case models.FieldDescription:
+			value = task.Description
+		case models.FieldCompleted, models.FieldStatus:
+			// Completed only changes with the status, see models.Workflow.Settle
+			args = append(args, task.Completed, task.Status, task.CompletedAt)
+			set += fmt.Sprintf(", completed = $%d, status = $%d, completed_at = $%d", len(args)-2, len(args)-1, len(args))
+			continue
+		case models.FieldDueAt:
+			value = task.DueAt
+		case models.FieldRemindAt:
+			// A new reminder time re-arms the reminder
+			value = task.RemindAt
+			set += ", reminded_at = NULL"
+		case models.FieldPriority:
+			value = task.Priority
+		case models.FieldParentID:
+			// Top-level tasks store NULL, which the foreign key ignores
+			args = append(args, task.ParentID)
+			set += fmt.Sprintf(", parent_id = NULLIF($%d, '')", len(args))
+			continue
+		case models.FieldRecurrence:
+			// Setting a rule may start a series, see models.Task.StartSeries
+			args = append(args, task.Recurrence, task.SeriesID, task.Occurrence)
+			set += fmt.Sprintf(", recurrence = $%d, series_id = $%d, occurrence = $%d", len(args)-2, len(args)-1, len(args))
+			continue
+		default:
+			return "", nil, fmt.Errorf("cannot update unknown field %q: %w", field, ErrInvalid)
+		}
+		args = append(args, value)
+		set += fmt.Sprintf(", %s = $%d", field, len(args))
+	}
+
+	query := `UPDATE tasks SET ` + set + ` WHERE id = $1 AND version = $2 AND deleted_at IS NULL`
+	return query, args, nil
 }
 
-// DeleteTask removes a task by ID
-func (r *TaskRepository) DeleteTask(ctx context.Context, id string) error {
-	query := `DELETE FROM tasks WHERE id = $1`
+// truncateTimes rounds task's timestamps to the microseconds TIMESTAMPTZ stores,
+// keeping the caller's copy in sync with the row
+func truncateTimes(task *models.Task) {
+	task.CreatedAt = task.CreatedAt.Truncate(time.Microsecond)
+	task.UpdatedAt = task.UpdatedAt.Truncate(time.Microsecond)
+	for _, t := range []*time.Time{task.CompletedAt, task.DueAt, task.RemindAt} {
+		if t != nil {
+			*t = t.Truncate(time.Microsecond)
+		}
+	}
+}
 
-	result, err := r.db.ExecContext(ctx, query, id)
+// deleteTask moves a live task to the trash inside tx if its version matches
+func deleteTask(ctx context.Context, tx *sqlx.Tx, id string, version int64) (*models.Task, error) {
+	before, err := lockTask(ctx, tx, id, false, version)
 	if err != nil {
-		return fmt.Errorf("failed to delete task: %w", err)
+		return nil, err
 	}
+	return applyChange(ctx, tx, models.ChangeDeleted, before, deleteTaskQuery, id)
+}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+// lockTask reads a live task, or a trashed one when trashed is set, and locks its row
+// until tx ends. A non-zero version must match the stored one.
+func lockTask(ctx context.Context, tx *sqlx.Tx, id string, trashed bool, version int64) (*models.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1 AND (deleted_at IS NOT NULL) = $2 FOR UPDATE`
+
+	var task models.Task
+	if err := tx.GetContext(ctx, &task, query, id, trashed); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if trashed {
+				return nil, fmt.Errorf("deleted task with ID %s: %w", id, ErrNotFound)
+			}
+			return nil, fmt.Errorf("task with ID %s: %w", id, ErrNotFound)
+		}
+		return nil, wrapError(ctx, "lock task", err)
+	}
+	if version != 0 && task.Version != version {
+		return nil, fmt.Errorf("task with ID %s is at version %d: %w", id, task.Version, ErrConflict)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("task not found with ID: %s", id)
+	return &task, nil
+}
+
+// applyChange runs an UPDATE of the task locked as before and records the change in
+// its history and the outbox. query must not have a RETURNING clause; the new row is returned.
+func applyChange(ctx context.Context, tx *sqlx.Tx, kind string, before *models.Task, query string, args ...interface{}) (*models.Task, error) {
+	var after models.Task
+	if err := tx.GetContext(ctx, &after, query+` RETURNING `+taskColumns, args...); err != nil {
+		return nil, wrapError(ctx, "apply "+kind+" change to task "+before.ID, err)
 	}
 
-	return nil
+	if err := recordChanges(ctx, tx, taskChange{kind: kind, before: before, after: &after}); err != nil {
+		return nil, err
+	}
+	return &after, nil
 }
@@ -0,0 +1,310 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// webhookColumns is the column list scanned into webhookRow
+const webhookColumns = `id, url, event_types, secret, created_at, failure_count, failing_since, disabled_at, disabled_reason`
+
+// webhookRow is one row of the webhooks table
+type webhookRow struct {
+	ID             string         `db:"id"`
+	URL            string         `db:"url"`
+	EventTypes     pq.StringArray `db:"event_types"`
+	Secret         string         `db:"secret"`
+	CreatedAt      time.Time      `db:"created_at"`
+	FailureCount   int            `db:"failure_count"`
+	FailingSince   *time.Time     `db:"failing_since"`
+	DisabledAt     *time.Time     `db:"disabled_at"`
+	DisabledReason string         `db:"disabled_reason"`
+}
+
+// webhook converts the row to its model
+func (row *webhookRow) webhook() *models.Webhook {
+	return &models.Webhook{
+		ID:             row.ID,
+		URL:            row.URL,
+		EventTypes:     []string(row.EventTypes),
+		Secret:         row.Secret,
+		CreatedAt:      row.CreatedAt,
+		FailureCount:   row.FailureCount,
+		FailingSince:   row.FailingSince,
+		DisabledAt:     row.DisabledAt,
+		DisabledReason: row.DisabledReason,
+	}
+}
+
+// claimedDeliveryRow is one delivery returned by ClaimWebhookDeliveries
+type claimedDeliveryRow struct {
+	ID        int64     `db:"id"`
+	EventType string    `db:"event_type"`
+	TaskID    string    `db:"task_id"`
+	Payload   []byte    `db:"payload"`
+	CreatedAt time.Time `db:"created_at"`
+	Attempts  int       `db:"attempts"`
+	WebhookID string    `db:"webhook_id"`
+	URL       string    `db:"url"`
+	Secret    string    `db:"secret"`
+}
+
+// attemptRow is one row of the webhook_attempts table
+type attemptRow struct {
+	ID            int64      `db:"id"`
+	DeliveryID    int64      `db:"delivery_id"`
+	WebhookID     string     `db:"webhook_id"`
+	EventType     string     `db:"event_type"`
+	TaskID        string     `db:"task_id"`
+	Attempt       int        `db:"attempt"`
+	StatusCode    int        `db:"status_code"`
+	Error         string     `db:"error"`
+	DurationMS    int64      `db:"duration_ms"`
+	AttemptedAt   time.Time  `db:"attempted_at"`
+	NextAttemptAt *time.Time `db:"next_attempt_at"`
+}
+
+// CreateWebhook adds a new webhook
+func (r *TaskRepository) CreateWebhook(ctx context.Context, hook *models.Webhook) error {
+	query := `
+    INSERT INTO webhooks (id, url, event_types, secret, created_at)
+    VALUES ($1, $2, $3, $4, $5)`
+
+	hook.CreatedAt = hook.CreatedAt.Truncate(time.Microsecond)
+	_, err := r.db.ExecContext(ctx, query,
+		hook.ID, hook.URL, pq.Array(hook.EventTypes), hook.Secret, hook.CreatedAt)
+	if err != nil {
+		return wrapError(ctx, "create webhook "+hook.ID, err)
+	}
+	return nil
+}
+
+// ListWebhooks lists every webhook, oldest first
+func (r *TaskRepository) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	var rows []webhookRow
+	query := `SELECT ` + webhookColumns + ` FROM webhooks ORDER BY created_at, id`
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, wrapError(ctx, "list webhooks", err)
+	}
+
+	hooks := make([]*models.Webhook, len(rows))
+	for i := range rows {
+		hooks[i] = rows[i].webhook()
+	}
+	return hooks, nil
+}
+
+// DeleteWebhook removes a webhook; its deliveries and log go with it by cascade
+func (r *TaskRepository) DeleteWebhook(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return wrapError(ctx, "delete webhook "+id, err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return wrapError(ctx, "delete webhook "+id, err)
+	} else if n == 0 {
+		return fmt.Errorf("webhook with ID %s: %w", id, ErrWebhookNotFound)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries lists a webhook's delivery attempts, newest first
+func (r *TaskRepository) ListWebhookDeliveries(ctx context.Context, req *models.ListWebhookDeliveriesRequest) (*models.ListWebhookDeliveriesResponse, error) {
+	pageSize := models.NormalizePageSize(req.PageSize)
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM webhooks WHERE id = $1)`, req.WebhookID); err != nil {
+		return nil, wrapError(ctx, "get webhook", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("webhook with ID %s: %w", req.WebhookID, ErrWebhookNotFound)
+	}
+
+	query := `
+    SELECT id, delivery_id, webhook_id, event_type, task_id, attempt, status_code, error,
+           duration_ms, attempted_at, next_attempt_at
+    FROM webhook_attempts WHERE webhook_id = $1`
+	args := []interface{}{req.WebhookID}
+	if req.Cursor != nil {
+		query += ` AND id < $2`
+		args = append(args, req.Cursor.ID)
+	}
+	query += fmt.Sprintf(` ORDER BY id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize+1)
+
+	var rows []attemptRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, wrapError(ctx, "list webhook deliveries", err)
+	}
+
+	attempts := make([]*models.WebhookAttempt, len(rows))
+	for i, row := range rows {
+		attempts[i] = &models.WebhookAttempt{
+			ID:            row.ID,
+			DeliveryID:    row.DeliveryID,
+			WebhookID:     row.WebhookID,
+			EventType:     row.EventType,
+			TaskID:        row.TaskID,
+			Attempt:       row.Attempt,
+			StatusCode:    row.StatusCode,
+			Error:         row.Error,
+			Duration:      time.Duration(row.DurationMS) * time.Millisecond,
+			AttemptedAt:   row.AttemptedAt,
+			NextAttemptAt: row.NextAttemptAt,
+		}
+	}
+
+	return models.NewListWebhookDeliveriesResponse(attempts, pageSize), nil
+}
+
+// ClaimWebhookDeliveries leases due deliveries by pushing back their next attempt.
+// SKIP LOCKED lets several workers claim side by side without waiting on each other.
+func (r *TaskRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	query := `
+    WITH due AS (
+        SELECT d.id
+        FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+        WHERE d.next_attempt_at <= now() AND w.disabled_at IS NULL
+        ORDER BY d.next_attempt_at, d.id
+        LIMIT $1
+        FOR UPDATE OF d SKIP LOCKED
+    )
+    UPDATE webhook_deliveries d
+    SET next_attempt_at = now() + make_interval(secs => $2)
+    FROM due, webhooks w
+    WHERE d.id = due.id AND w.id = d.webhook_id
+    RETURNING d.id, d.event_type, d.task_id, d.payload, d.created_at, d.attempts,
+              w.id AS webhook_id, w.url, w.secret`
+
+	var rows []claimedDeliveryRow
+	if err := r.db.SelectContext(ctx, &rows, query, limit, lease.Seconds()); err != nil {
+		return nil, wrapError(ctx, "claim webhook deliveries", err)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+
+	deliveries := make([]*models.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = &models.WebhookDelivery{
+			ID:        row.ID,
+			Webhook:   &models.Webhook{ID: row.WebhookID, URL: row.URL, Secret: row.Secret},
+			EventType: row.EventType,
+			TaskID:    row.TaskID,
+			Payload:   row.Payload,
+			CreatedAt: row.CreatedAt,
+			Attempts:  row.Attempts,
+		}
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt logs attempt and updates its delivery and webhook in one
+// transaction. Attempts for webhooks deleted since the claim are discarded.
+func (r *TaskRepository) RecordWebhookAttempt(ctx context.Context, attempt *models.WebhookAttempt, policy models.WebhookDisablePolicy) (bool, error) {
+	var disabled bool
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		var row webhookRow
+		query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1 FOR UPDATE`
+		if err := tx.GetContext(ctx, &row, query, attempt.WebhookID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return wrapError(ctx, "lock webhook", err)
+		}
+
+		query = `
+    INSERT INTO webhook_attempts (delivery_id, webhook_id, event_type, task_id, attempt,
+                                  status_code, error, duration_ms, attempted_at, next_attempt_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    RETURNING id`
+		err := tx.GetContext(ctx, &attempt.ID, query, attempt.DeliveryID, attempt.WebhookID,
+			attempt.EventType, attempt.TaskID, attempt.Attempt, attempt.StatusCode, attempt.Error,
+			attempt.Duration.Milliseconds(), attempt.AttemptedAt, attempt.NextAttemptAt)
+		if err != nil {
+			return wrapError(ctx, "log webhook attempt", err)
+		}
+
+		if attempt.NextAttemptAt == nil {
+			_, err = tx.ExecContext(ctx, `DELETE FROM webhook_deliveries WHERE id = $1`, attempt.DeliveryID)
+		} else {
+			_, err = tx.ExecContext(ctx, `UPDATE webhook_deliveries SET attempts = $2, next_attempt_at = $3 WHERE id = $1`,
+				attempt.DeliveryID, attempt.Attempt, *attempt.NextAttemptAt)
+		}
+		if err != nil {
+			return wrapError(ctx, "update webhook delivery", err)
+		}
+
+		hook := row.webhook()
+		disabled = hook.RecordAttempt(attempt, policy)
+		query = `
+    UPDATE webhooks
+    SET failure_count = $2, failing_since = $3, disabled_at = $4, disabled_reason = $5
+    WHERE id = $1`
+		_, err = tx.ExecContext(ctx, query,
+			hook.ID, hook.FailureCount, hook.FailingSince, hook.DisabledAt, hook.DisabledReason)
+		if err != nil {
+			return wrapError(ctx, "update webhook", err)
+		}
+
+		if disabled {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM webhook_deliveries WHERE webhook_id = $1`, hook.ID); err != nil {
+				return wrapError(ctx, "drop deliveries of disabled webhook", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return disabled, nil
+}
+
+// PruneWebhookDeliveries drops delivery log entries recorded before cutoff
+func (r *TaskRepository) PruneWebhookDeliveries(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_attempts WHERE attempted_at < $1`, cutoff)
+	if err != nil {
+		return 0, wrapError(ctx, "prune webhook deliveries", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, wrapError(ctx, "prune webhook deliveries", err)
+	}
+	return n, nil
+}
+
+// enqueueWebhookDeliveries queues a delivery of each change to every enabled webhook
+// subscribed to one of its event types, under the most specific type it accepts
+func enqueueWebhookDeliveries(ctx context.Context, tx sqlx.ExecerContext, changes []taskChange, payloads [][]byte) error {
+	values := make([]string, len(changes))
+	args := make([]interface{}, 0, len(changes)*3)
+	for i, c := range changes {
+		n := len(args)
+		values[i] = fmt.Sprintf("(%d, $%d, $%d::text[], $%d::jsonb)", i, n+1, n+2, n+3)
+		args = append(args, c.after.ID, pq.Array(models.EventTypes(c.kind, c.before, c.after)), string(payloads[i]))
+	}
+
+	query := `
+    INSERT INTO webhook_deliveries (webhook_id, event_type, task_id, payload)
+    SELECT w.id, t.type, e.task_id, e.payload
+    FROM (VALUES ` + strings.Join(values, ", ") + `) AS e (n, task_id, types, payload)
+    JOIN webhooks w ON w.disabled_at IS NULL
+    CROSS JOIN LATERAL (
+        SELECT u.type FROM unnest(e.types) WITH ORDINALITY AS u (type, n)
+        WHERE cardinality(w.event_types) = 0 OR u.type = ANY (w.event_types)
+        ORDER BY u.n
+        LIMIT 1
+    ) t
+    ORDER BY e.n, w.id`
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return wrapError(ctx, "enqueue webhook deliveries", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// SettleStatuses moves tasks in a status the workflow lacks, such as the todo and done
+// the status migration gave every task, to its first done status when they are
+// completed and to its initial status otherwise. It returns how many tasks moved.
+// Like a migration it writes no history or webhook deliveries.
+func (r *TaskRepository) SettleStatuses(ctx context.Context) (int64, error) {
+	query := `
+    UPDATE tasks SET
+        status = CASE WHEN completed THEN $2 ELSE $3 END,
+        completed_at = CASE WHEN completed THEN COALESCE(completed_at, updated_at) END,
+        updated_at = now(),
+        version = version + 1
+    WHERE status <> ALL ($1)`
+
+	result, err := r.db.ExecContext(ctx, query, pq.Array(r.workflow.Statuses()), r.workflow.FirstDone(), r.workflow.Initial())
+	if err != nil {
+		return 0, wrapError(ctx, "settle task statuses", err)
+	}
+
+	settled, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return settled, nil
+}
+
+// TransitionTask moves a task to another status if the workflow allows it and its version matches
+func (r *TaskRepository) TransitionTask(ctx context.Context, req *models.TransitionTaskRequest) (*models.Task, error) {
+	var task *models.Task
+	err := r.inTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := lockTask(ctx, tx, req.ID, false, req.Version)
+		if err != nil {
+			return err
+		}
+		if before.Status == req.Status {
+			// Nothing changed, so keep the version
+			task = before
+			return nil
+		}
+
+		after, err := transition(r.workflow, before, req, time.Now().Truncate(time.Microsecond))
+		if err != nil {
+			return err
+		}
+		query, args, err := updateStatement(after, []string{models.FieldStatus})
+		if err != nil {
+			return err
+		}
+		if task, err = applyChange(ctx, tx, models.ChangeUpdated, before, query, args...); err != nil {
+			return err
+		}
+		return r.createNextOccurrence(ctx, tx, before, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// transition returns a copy of before moved to req.Status at now. It fails with
+// ErrInvalidTransition if workflow does not allow the move and with ErrTaskBlocked if
+// it completes a blocked task without req.IgnoreBlockers.
+func transition(workflow *models.Workflow, before *models.Task, req *models.TransitionTaskRequest, now time.Time) (*models.Task, error) {
+	if !workflow.Allows(before.Status, req.Status) {
+		return nil, fmt.Errorf("task with ID %s cannot move from %s to %s: %w", before.ID, before.Status, req.Status, ErrInvalidTransition)
+	}
+
+	after := *before
+	after.Status, after.UpdatedAt = req.Status, now
+	workflow.Settle(before, &after)
+	if err := checkBlocked(before, &after, []string{models.FieldStatus}, req.IgnoreBlockers); err != nil {
+		return nil, err
+	}
+	return &after, nil
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+)
+
+func TestSettleStatuses(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewTaskRepository(db, testWorkflow(t))
+	ctx := context.Background()
+
+	open, completed, known := newTestTask("open"), newTestTask("completed"), newTestTask("known")
+	for _, task := range []*models.Task{open, completed, known} {
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+
+	// Statuses from a workflow the server no longer runs
+	for _, update := range []struct {
+		id, status string
+		completed  bool
+	}{{open.ID, "building", false}, {completed.ID, "shipped", true}} {
+		if _, err := db.ExecContext(ctx, `UPDATE tasks SET status = $2, completed = $3 WHERE id = $1`,
+			update.id, update.status, update.completed); err != nil {
+			t.Fatalf("set status %s: %v", update.status, err)
+		}
+	}
+
+	settled, err := repo.SettleStatuses(ctx)
+	if err != nil {
+		t.Fatalf("SettleStatuses: %v", err)
+	}
+	if settled < 2 {
+		t.Errorf("SettleStatuses moved %d tasks, want at least 2", settled)
+	}
+
+	for _, want := range []struct {
+		task      *models.Task
+		status    string
+		completed bool
+		version   int64
+	}{
+		{open, "todo", false, 2},
+		{completed, "done", true, 2},
+		{known, "todo", false, 1},
+	} {
+		got, err := repo.GetTask(ctx, want.task.ID)
+		if err != nil {
+			t.Fatalf("GetTask: %v", err)
+		}
+		if got.Status != want.status || got.Completed != want.completed || got.Version != want.version {
+			t.Errorf("task %q = status %q, completed %t, version %d; want %q, %t, %d", got.Title,
+				got.Status, got.Completed, got.Version, want.status, want.completed, want.version)
+		}
+		if (got.CompletedAt != nil) != want.completed {
+			t.Errorf("task %q has CompletedAt %v, want it set only when completed", got.Title, got.CompletedAt)
+		}
+	}
+
+	if settled, err := repo.SettleStatuses(ctx); err != nil || settled != 0 {
+		t.Errorf("second SettleStatuses = %d, %v; want 0", settled, err)
+	}
+}
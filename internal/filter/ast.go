@@ -0,0 +1,102 @@
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr is a node of a filter expression tree
+type Expr interface {
+	// String renders the node in canonical filter syntax
+	String() string
+}
+
+// And matches when both operands match
+type And struct {
+	Left, Right Expr
+}
+
+// Or matches when either operand matches
+type Or struct {
+	Left, Right Expr
+}
+
+// Not negates its operand
+type Not struct {
+	X Expr
+}
+
+// Comparison compares a field with a literal. After Check, Value holds a
+// string, bool, int64 or time.Time matching the field Type.
+type Comparison struct {
+	Field string
+	Op    string
+	Value interface{}
+	Type  Type
+}
+
+// Literal is an unchecked value as written in the expression
+type Literal struct {
+	Text   string
+	Quoted bool
+}
+
+func (e *And) String() string { return "(" + e.Left.String() + " AND " + e.Right.String() + ")" }
+func (e *Or) String() string  { return "(" + e.Left.String() + " OR " + e.Right.String() + ")" }
+func (e *Not) String() string { return "NOT " + e.X.String() }
+
+func (e *Comparison) String() string {
+	var value string
+	switch v := e.Value.(type) {
+	case Literal:
+		value = strconv.Quote(v.Text)
+	case string:
+		value = strconv.Quote(v)
+	case time.Time:
+		value = strconv.Quote(v.UTC().Format(time.RFC3339Nano))
+	default:
+		value = fmt.Sprint(v)
+	}
+	return e.Field + " " + e.Op + " " + value
+}
+
+// Type is the value type of a filterable field
+type Type int
+
+const (
+	TypeString Type = iota + 1
+	TypeBool
+	TypeInt
+	TypeTimestamp
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeString:
+		return "string"
+	case TypeBool:
+		return "bool"
+	case TypeInt:
+		return "int"
+	case TypeTimestamp:
+		return "timestamp"
+	default:
+		return "unknown"
+	}
+}
+
+// Schema maps filterable field names to their types
+type Schema map[string]Type
+
+// fieldNames lists the schema fields for error messages
+func (s Schema) fieldNames() string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
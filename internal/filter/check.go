@@ -0,0 +1,93 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Check resolves field names against schema, converts literals to typed
+// values and rejects operators that do not apply to the field type
+func Check(expr Expr, schema Schema) (Expr, error) {
+	switch e := expr.(type) {
+	case *And:
+		left, right, err := checkPair(e.Left, e.Right, schema)
+		if err != nil {
+			return nil, err
+		}
+		return &And{Left: left, Right: right}, nil
+	case *Or:
+		left, right, err := checkPair(e.Left, e.Right, schema)
+		if err != nil {
+			return nil, err
+		}
+		return &Or{Left: left, Right: right}, nil
+	case *Not:
+		x, err := Check(e.X, schema)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{X: x}, nil
+	case *Comparison:
+		return checkComparison(e, schema)
+	default:
+		return nil, fmt.Errorf("unsupported filter node %T", expr)
+	}
+}
+
+func checkPair(left, right Expr, schema Schema) (Expr, Expr, error) {
+	l, err := Check(left, schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := Check(right, schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func checkComparison(c *Comparison, schema Schema) (Expr, error) {
+	typ, ok := schema[c.Field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q; filterable fields are %s", c.Field, schema.fieldNames())
+	}
+
+	switch c.Op {
+	case "=", "!=":
+	case "<", "<=", ">", ">=":
+		if typ == TypeBool {
+			return nil, fmt.Errorf("operator %s is not supported for bool field %s", c.Op, c.Field)
+		}
+	case ":":
+		if typ != TypeString {
+			return nil, fmt.Errorf("operator : is only supported for string fields, not %s", c.Field)
+		}
+	default:
+		return nil, fmt.Errorf("unknown operator %q", c.Op)
+	}
+
+	lit, ok := c.Value.(Literal)
+	if !ok {
+		// Already checked
+		return c, nil
+	}
+
+	var value interface{}
+	var err error
+	switch typ {
+	case TypeString:
+		value = lit.Text
+	case TypeBool:
+		value, err = strconv.ParseBool(lit.Text)
+	case TypeInt:
+		value, err = strconv.ParseInt(lit.Text, 10, 64)
+	case TypeTimestamp:
+		value, err = time.Parse(time.RFC3339Nano, lit.Text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("field %s expects a %s value, got %q", c.Field, typ, lit.Text)
+	}
+
+	return &Comparison{Field: c.Field, Op: c.Op, Value: value, Type: typ}, nil
+}
@@ -0,0 +1,103 @@
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+// testSchema has one field of each type
+var testSchema = Schema{
+	"title":      TypeString,
+	"completed":  TypeBool,
+	"priority":   TypeInt,
+	"created_at": TypeTimestamp,
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`title = deploy`, `title = "deploy"`},
+		{`completed = true`, `completed = true`},
+		{`priority >= 2`, `priority >= 2`},
+		{`created_at < "2026-01-02T03:04:05Z"`, `created_at < "2026-01-02T03:04:05Z"`},
+		{`title:deploy`, `title : "deploy"`},
+		// Checking keeps the shape of the tree, OR inside AND
+		{`completed = false AND priority = 1 OR priority = 3`, `(completed = false AND (priority = 1 OR priority = 3))`},
+		{`NOT completed = true title:x`, `(NOT completed = true AND title : "x")`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := Compile(tt.input, testSchema)
+			if err != nil {
+				t.Fatalf("Compile(%q): %v", tt.input, err)
+			}
+			if got := expr.String(); got != tt.want {
+				t.Errorf("Compile(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileTypes(t *testing.T) {
+	expr, err := Compile(`title = x AND completed = true AND priority = 3 AND created_at = "2026-01-02T03:04:05Z"`, testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"title":      "x",
+		"completed":  true,
+		"priority":   int64(3),
+		"created_at": time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	var walk func(Expr)
+	walk = func(e Expr) {
+		switch e := e.(type) {
+		case *And:
+			walk(e.Left)
+			walk(e.Right)
+		case *Comparison:
+			if w := want[e.Field]; e.Value != w && !isSameTime(e.Value, w) {
+				t.Errorf("%s checked to %#v, want %#v", e.Field, e.Value, w)
+			}
+			if e.Type != testSchema[e.Field] {
+				t.Errorf("%s has type %s, want %s", e.Field, e.Type, testSchema[e.Field])
+			}
+		default:
+			t.Fatalf("unexpected node %T", e)
+		}
+	}
+	walk(expr)
+}
+
+func isSameTime(a, b interface{}) bool {
+	ta, ok1 := a.(time.Time)
+	tb, ok2 := b.(time.Time)
+	return ok1 && ok2 && ta.Equal(tb)
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []string{
+		`unknown = 1`,
+		`completed = maybe`,
+		`completed < true`,
+		`priority = high`,
+		`priority:1`,
+		`created_at > yesterday`,
+		`title = x OR nope = 1`,
+		`title = x AND priority = 1 OR completed:yes`,
+	}
+	for _, input := range tests {
+		if expr, err := Compile(input, testSchema); err == nil {
+			t.Errorf("Compile(%q) = %v, want an error", input, expr)
+		}
+	}
+}
+
+func TestCompileEmpty(t *testing.T) {
+	expr, err := Compile("  ", testSchema)
+	if expr != nil || err != nil {
+		t.Errorf("Compile of a blank filter = %v, %v; want nil, nil", expr, err)
+	}
+}
@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"strings"
+	"time"
+)
+
+// Record exposes field values to Eval. FieldValue returns a string, bool,
+// int64 or time.Time matching the schema type of the field.
+type Record interface {
+	FieldValue(field string) interface{}
+}
+
+// Eval evaluates a checked expression against a record, for stores without SQL
+func Eval(expr Expr, r Record) bool {
+	switch e := expr.(type) {
+	case *And:
+		return Eval(e.Left, r) && Eval(e.Right, r)
+	case *Or:
+		return Eval(e.Left, r) || Eval(e.Right, r)
+	case *Not:
+		return !Eval(e.X, r)
+	case *Comparison:
+		return evalComparison(e, r.FieldValue(e.Field))
+	default:
+		return false
+	}
+}
+
+func evalComparison(c *Comparison, actual interface{}) bool {
+	var cmp int
+	switch want := c.Value.(type) {
+	case string:
+		got, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		if c.Op == ":" {
+			return strings.Contains(strings.ToLower(got), strings.ToLower(want))
+		}
+		cmp = strings.Compare(got, want)
+	case bool:
+		got, ok := actual.(bool)
+		if !ok {
+			return false
+		}
+		if got != want {
+			cmp = 1
+		}
+	case int64:
+		got, ok := actual.(int64)
+		if !ok {
+			return false
+		}
+		switch {
+		case got < want:
+			cmp = -1
+		case got > want:
+			cmp = 1
+		}
+	case time.Time:
+		got, ok := actual.(time.Time)
+		if !ok {
+			return false
+		}
+		cmp = got.Compare(want)
+	default:
+		return false
+	}
+
+	switch c.Op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
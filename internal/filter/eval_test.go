@@ -0,0 +1,74 @@
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+// testRecord implements Record over a map
+type testRecord map[string]interface{}
+
+func (r testRecord) FieldValue(field string) interface{} {
+	return r[field]
+}
+
+func TestEval(t *testing.T) {
+	record := testRecord{
+		"title":      "Deploy the API",
+		"completed":  false,
+		"priority":   int64(1),
+		"created_at": time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{`title = "Deploy the API"`, true},
+		{`title = deploy`, false},
+		{`title:deploy`, true},
+		{`title:DEPLOY`, true},
+		{`title != other`, true},
+		{`title < E`, true},
+		{`completed = false`, true},
+		{`completed != false`, false},
+		{`priority = 1`, true},
+		{`priority > 1`, false},
+		{`priority <= 1`, true},
+		{`created_at >= "2026-01-02T00:00:00Z"`, true},
+		{`created_at > "2026-01-02T00:00:00Z"`, false},
+		{`created_at < "2026-01-02T01:00:00+01:00"`, false},
+		{`NOT completed = true`, true},
+		{`-priority = 1`, false},
+		// OR binds tighter than AND; grouping (a AND b) OR c would flip the
+		// second and third of these
+		{`completed = false AND priority = 5 OR priority = 1`, true},
+		{`completed = true AND priority = 5 OR priority = 1`, false},
+		{`priority = 1 OR completed = true AND priority = 5`, false},
+		{`(completed = true AND priority = 5) OR priority = 1`, true},
+		{`priority = 1 title:nope OR title:deploy`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := Compile(tt.input, testSchema)
+			if err != nil {
+				t.Fatalf("Compile(%q): %v", tt.input, err)
+			}
+			if got := Eval(expr, record); got != tt.want {
+				t.Errorf("Eval(%s) = %t, want %t", expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalMismatchedValue(t *testing.T) {
+	expr, err := Compile(`priority = 1`, testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Eval(expr, testRecord{"priority": "1"}) {
+		t.Error("a string value matched an int comparison")
+	}
+	if Eval(expr, testRecord{}) {
+		t.Error("a missing value matched")
+	}
+}
@@ -0,0 +1,97 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind classifies lexer tokens
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokLParen
+	tokRParen
+	tokOp     // = != < <= > >= :
+	tokString // quoted literal, unescaped
+	tokWord   // bare word: keyword, field name, number or unquoted value
+	tokMinus  // "-" used as NOT
+)
+
+// token is one lexeme with its byte offset in the input
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// lex splits a filter expression into tokens
+func lex(input string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(input) {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+		case c == '=' || c == ':':
+			tokens = append(tokens, token{tokOp, string(c), i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(input) && input[i+1] == '=' {
+				tokens = append(tokens, token{tokOp, input[i : i+2], i})
+				i += 2
+			} else if c == '!' {
+				return nil, fmt.Errorf("unexpected '!' at position %d", i)
+			} else {
+				tokens = append(tokens, token{tokOp, string(c), i})
+				i++
+			}
+		case c == '"' || c == '\'':
+			text, n, err := lexString(input[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v at position %d", err, i)
+			}
+			tokens = append(tokens, token{tokString, text, i})
+			i += n
+		case c == '-' && i+1 < len(input) && (input[i+1] == '(' || unicode.IsLetter(rune(input[i+1]))):
+			tokens = append(tokens, token{tokMinus, "-", i})
+			i++
+		default:
+			start := i
+			for i < len(input) && !strings.ContainsRune(" \t\n\r()=:!<>\"'", rune(input[i])) {
+				i++
+			}
+			tokens = append(tokens, token{tokWord, input[start:i], start})
+		}
+	}
+	return append(tokens, token{tokEOF, "", len(input)}), nil
+}
+
+// lexString reads a quoted literal and returns its unescaped text and encoded length
+func lexString(input string) (string, int, error) {
+	quote := input[0]
+	var b strings.Builder
+	for i := 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if i+1 == len(input) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			i++
+			b.WriteByte(input[i])
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(input[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
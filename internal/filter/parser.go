@@ -0,0 +1,195 @@
+package filter
+
+import "fmt"
+
+// Limits that keep hostile filters cheap to parse and execute
+const (
+	MaxLength = 2000
+	MaxDepth  = 32
+)
+
+// parser is a recursive-descent parser over the AIP-160 grammar, in which OR binds
+// tighter than AND, so "a AND b OR c" means "a AND (b OR c)"
+//
+//	expr       = sequence { "AND" sequence }
+//	sequence   = factor { factor }
+//	factor     = unary { "OR" unary }
+//	unary      = [ "NOT" | "-" ] primary
+//	primary    = "(" expr ")" | comparison
+//	comparison = field op value
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// Parse parses a filter expression into an unchecked tree. An empty input yields nil.
+func Parse(input string) (Expr, error) {
+	if len(input) > MaxLength {
+		return nil, fmt.Errorf("filter exceeds %d characters", MaxLength)
+	}
+
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return nil, nil
+	}
+
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+	return expr, nil
+}
+
+// Compile parses input and type-checks it against schema
+func Compile(input string, schema Schema) (Expr, error) {
+	expr, err := Parse(input)
+	if err != nil || expr == nil {
+		return nil, err
+	}
+	return Check(expr, schema)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// isKeyword reports whether tok is the bare keyword kw
+func isKeyword(tok token, kw string) bool {
+	return tok.kind == tokWord && tok.text == kw
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	left, err := p.parseSequence()
+	if err != nil {
+		return nil, err
+	}
+	for isKeyword(p.peek(), "AND") {
+		p.next()
+		right, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseSequence conjoins adjacent factors written without AND, as in AIP-160
+func (p *parser) parseSequence() (Expr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind == tokEOF || tok.kind == tokRParen || isKeyword(tok, "AND") {
+			return left, nil
+		}
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseFactor() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for isKeyword(p.peek(), "OR") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	tok := p.peek()
+	if isKeyword(tok, "NOT") || tok.kind == tokMinus {
+		p.next()
+		x, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{X: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokLParen:
+		p.depth++
+		if p.depth > MaxDepth {
+			return nil, fmt.Errorf("filter nests deeper than %d levels", MaxDepth)
+		}
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, fmt.Errorf("expected ')' at position %d", closing.pos)
+		}
+		p.depth--
+		return expr, nil
+	case tokWord:
+		if isReserved(tok.text) {
+			return nil, fmt.Errorf("unexpected %s at position %d", tok.text, tok.pos)
+		}
+		return p.parseComparison(tok)
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of filter")
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+}
+
+func (p *parser) parseComparison(field token) (Expr, error) {
+	op := p.next()
+	if op.kind != tokOp {
+		return nil, fmt.Errorf("expected comparison operator after %q at position %d", field.text, op.pos)
+	}
+
+	value := p.next()
+	switch {
+	case value.kind == tokString:
+		return &Comparison{Field: field.text, Op: op.text, Value: Literal{Text: value.text, Quoted: true}}, nil
+	case value.kind == tokWord && !isReserved(value.text):
+		return &Comparison{Field: field.text, Op: op.text, Value: Literal{Text: value.text}}, nil
+	default:
+		return nil, fmt.Errorf("expected value after %s %s at position %d", field.text, op.text, value.pos)
+	}
+}
+
+// isReserved reports whether word is a logical keyword
+func isReserved(word string) bool {
+	switch word {
+	case "AND", "OR", "NOT":
+		return true
+	}
+	return false
+}
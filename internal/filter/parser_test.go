@@ -0,0 +1,73 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string // String() of the tree; "" for an empty filter
+	}{
+		{``, ``},
+		{`a = 1`, `a = "1"`},
+		{`a = 1 AND b = 2`, `(a = "1" AND b = "2")`},
+		{`a = 1 OR b = 2`, `(a = "1" OR b = "2")`},
+		// OR binds tighter than AND
+		{`a = 1 AND b = 2 OR c = 3`, `(a = "1" AND (b = "2" OR c = "3"))`},
+		{`a = 1 OR b = 2 AND c = 3`, `((a = "1" OR b = "2") AND c = "3")`},
+		{`a = 1 OR b = 2 AND c = 3 OR d = 4`, `((a = "1" OR b = "2") AND (c = "3" OR d = "4"))`},
+		// Adjacent terms are conjoined, and still bind looser than OR
+		{`a = 1 b = 2`, `(a = "1" AND b = "2")`},
+		{`a = 1 b = 2 OR c = 3`, `(a = "1" AND (b = "2" OR c = "3"))`},
+		{`a = 1 OR b = 2 c = 3`, `((a = "1" OR b = "2") AND c = "3")`},
+		// Parentheses override precedence
+		{`(a = 1 AND b = 2) OR c = 3`, `((a = "1" AND b = "2") OR c = "3")`},
+		{`NOT a = 1 OR b = 2`, `(NOT a = "1" OR b = "2")`},
+		{`-a = 1`, `NOT a = "1"`},
+		{`-(a = 1 OR b = 2)`, `NOT (a = "1" OR b = "2")`},
+		{`title:"deploy now" AND done = false`, `(title : "deploy now" AND done = "false")`},
+		{`a != 'it\'s'`, `a != "it's"`},
+		{`a <= 1 AND b > 2`, `(a <= "1" AND b > "2")`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.input, err)
+			}
+			var got string
+			if expr != nil {
+				got = expr.String()
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		`a`,
+		`a =`,
+		`= 1`,
+		`a = 1 AND`,
+		`a = 1 OR`,
+		`OR a = 1`,
+		`a = 1 AND OR b = 2`,
+		`(a = 1`,
+		`a = 1)`,
+		`a = "open`,
+		`a ! 1`,
+		`a = AND`,
+		strings.Repeat("(", MaxDepth+1) + "a = 1" + strings.Repeat(")", MaxDepth+1),
+		"a = " + strings.Repeat("x", MaxLength),
+	}
+	for _, input := range tests {
+		if expr, err := Parse(input); err == nil {
+			t.Errorf("Parse(%.40q) = %v, want an error", input, expr)
+		}
+	}
+}
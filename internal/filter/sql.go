@@ -0,0 +1,52 @@
+package filter
+
+import "fmt"
+
+// ToSQL translates a checked expression into a SQL boolean expression.
+// columns maps each field to its SQL column expression; arg registers a
+// bind parameter and returns its placeholder, so values never reach the SQL text.
+func ToSQL(expr Expr, columns map[string]string, arg func(interface{}) string) (string, error) {
+	switch e := expr.(type) {
+	case *And:
+		return binarySQL(e.Left, e.Right, "AND", columns, arg)
+	case *Or:
+		return binarySQL(e.Left, e.Right, "OR", columns, arg)
+	case *Not:
+		x, err := ToSQL(e.X, columns, arg)
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + x + ")", nil
+	case *Comparison:
+		column, ok := columns[e.Field]
+		if !ok {
+			return "", fmt.Errorf("field %s has no column", e.Field)
+		}
+		if _, unchecked := e.Value.(Literal); unchecked {
+			return "", fmt.Errorf("filter on %s has not been type-checked", e.Field)
+		}
+
+		switch e.Op {
+		case ":":
+			return fmt.Sprintf("strpos(lower(%s), lower(%s)) > 0", column, arg(e.Value)), nil
+		case "!=":
+			return fmt.Sprintf("%s <> %s", column, arg(e.Value)), nil
+		default:
+			return fmt.Sprintf("%s %s %s", column, e.Op, arg(e.Value)), nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported filter node %T", expr)
+	}
+}
+
+func binarySQL(left, right Expr, op string, columns map[string]string, arg func(interface{}) string) (string, error) {
+	l, err := ToSQL(left, columns, arg)
+	if err != nil {
+		return "", err
+	}
+	r, err := ToSQL(right, columns, arg)
+	if err != nil {
+		return "", err
+	}
+	return "(" + l + " " + op + " " + r + ")", nil
+}
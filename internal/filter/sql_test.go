@@ -0,0 +1,80 @@
+package filter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// testColumns maps testSchema to SQL columns
+var testColumns = map[string]string{
+	"title":      "t.title",
+	"completed":  "t.completed",
+	"priority":   "t.priority",
+	"created_at": "t.created_at",
+}
+
+func TestToSQL(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{`title = x`, `t.title = $1`, []interface{}{"x"}},
+		{`title != x`, `t.title <> $1`, []interface{}{"x"}},
+		{`title:x`, `strpos(lower(t.title), lower($1)) > 0`, []interface{}{"x"}},
+		{`priority >= 2`, `t.priority >= $1`, []interface{}{int64(2)}},
+		{`NOT completed = true`, `NOT (t.completed = $1)`, []interface{}{true}},
+		// OR binds tighter than AND, and the SQL keeps the grouping explicit
+		{`completed = false AND priority = 1 OR priority = 3`,
+			`(t.completed = $1 AND (t.priority = $2 OR t.priority = $3))`,
+			[]interface{}{false, int64(1), int64(3)}},
+		{`priority = 1 OR priority = 3 completed = false`,
+			`((t.priority = $1 OR t.priority = $2) AND t.completed = $3)`,
+			[]interface{}{int64(1), int64(3), false}},
+		{`(completed = false AND priority = 1) OR priority = 3`,
+			`((t.completed = $1 AND t.priority = $2) OR t.priority = $3)`,
+			[]interface{}{false, int64(1), int64(3)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := Compile(tt.input, testSchema)
+			if err != nil {
+				t.Fatalf("Compile(%q): %v", tt.input, err)
+			}
+			var args []interface{}
+			sql, err := ToSQL(expr, testColumns, func(v interface{}) string {
+				args = append(args, v)
+				return fmt.Sprintf("$%d", len(args))
+			})
+			if err != nil {
+				t.Fatalf("ToSQL(%s): %v", expr, err)
+			}
+			if sql != tt.wantSQL {
+				t.Errorf("ToSQL(%s) = %s, want %s", expr, sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("ToSQL(%s) args = %v, want %v", expr, args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestToSQLErrors(t *testing.T) {
+	unchecked, err := Parse(`title = x`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	arg := func(interface{}) string { return "?" }
+	if _, err := ToSQL(unchecked, testColumns, arg); err == nil {
+		t.Error("ToSQL accepted an unchecked expression")
+	}
+
+	checked, err := Compile(`title = x`, testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ToSQL(checked, map[string]string{}, arg); err == nil {
+		t.Error("ToSQL accepted a field without a column")
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import "fmt"
+
+// MaxBatchSize bounds the items in one batch request so it stays well under the
+// default gRPC message size; larger imports are split by the client
+const MaxBatchSize = 1000
+
+// DeleteTaskRequest represents the internal request for deleting a task
+type DeleteTaskRequest struct {
+	ID      string `json:"id"`
+	Version int64  `json:"version"` // expected current version; 0 skips the check
+}
+
+// Validate validates the delete task request
+func (r *DeleteTaskRequest) Validate() error {
+	var v ValidationError
+	if r.ID == "" {
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	}
+	return v.Err()
+}
+
+// BatchResult is the outcome of one batch item. Task is set for successful
+// creates and updates; Err is set when the item failed.
+type BatchResult struct {
+	Task *Task
+	Err  error
+}
+
+// ValidateBatchSize checks the number of items in a batch request
+func ValidateBatchSize(n int) error {
+	var v ValidationError
+	if n == 0 {
+		v.Add("requests", ReasonRequired, "requests cannot be empty")
+	} else if n > MaxBatchSize {
+		v.Add("requests", ReasonTooLong, fmt.Sprintf("requests cannot exceed %d items", MaxBatchSize))
+	}
+	return v.Err()
+}
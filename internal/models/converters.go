@@ -1,35 +1,79 @@
 package models
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
+	"github.com/Samarth11-A/TaskListAPI/internal/filter"
 	pb "github.com/Samarth11-A/TaskList_proto/api"
 )
 
 // ToProtoTask converts an internal Task to a protobuf Task
 func (t *Task) ToProtoTask() *pb.Task {
+	var deletedAt string
+	if t.DeletedAt != nil {
+		deletedAt = t.DeletedAt.UTC().Format(time.RFC3339Nano)
+	}
+
 	return &pb.Task{
 		Id:          t.ID,
 		Title:       t.Title,
 		Description: t.Description,
 		Completed:   t.Completed,
-		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   t.UpdatedAt.Format(time.RFC3339),
+		CreatedAt:   t.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:   t.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		Version:     t.Version,
+		DeletedAt:   deletedAt,
+		DueAt:       formatOptionalTime(t.DueAt),
+		RemindAt:    formatOptionalTime(t.RemindAt),
+		RemindedAt:  formatOptionalTime(t.RemindedAt),
+		Priority:    pb.Priority(t.Priority),
+		Position:    t.Position,
+		Labels:      toProtoLabels(t.Labels),
+		ProjectId:   t.ProjectID,
+		ParentId:    t.ParentID,
+		BlockedBy:   t.BlockedBy,
+		Blocked:     t.Blocked,
+		Recurrence:  t.Recurrence,
+		SeriesId:    t.SeriesID,
+		Occurrence:  int32(t.Occurrence),
+		Status:      t.Status,
+		CompletedAt: formatOptionalTime(t.CompletedAt),
 	}
 }
 
 // FromProtoTask converts a protobuf Task to an internal Task
 func FromProtoTask(protoTask *pb.Task) (*Task, error) {
-	createdAt, err := time.Parse(time.RFC3339, protoTask.CreatedAt)
+	createdAt, err := time.Parse(time.RFC3339Nano, protoTask.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	updatedAt, err := time.Parse(time.RFC3339, protoTask.UpdatedAt)
+	updatedAt, err := time.Parse(time.RFC3339Nano, protoTask.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
 
+	var deletedAt *time.Time
+	if protoTask.DeletedAt != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, protoTask.DeletedAt)
+		if err != nil {
+			return nil, err
+		}
+		deletedAt = &parsed
+	}
+
+	var v ValidationError
+	dueAt := parseOptionalTime(&v, "due_at", protoTask.DueAt)
+	remindAt := parseOptionalTime(&v, "remind_at", protoTask.RemindAt)
+	remindedAt := parseOptionalTime(&v, "reminded_at", protoTask.RemindedAt)
+	completedAt := parseOptionalTime(&v, "completed_at", protoTask.CompletedAt)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
 	return &Task{
 		ID:          protoTask.Id,
 		Title:       protoTask.Title,
@@ -37,33 +81,160 @@ func FromProtoTask(protoTask *pb.Task) (*Task, error) {
 		Completed:   protoTask.Completed,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
+		Version:     protoTask.Version,
+		DeletedAt:   deletedAt,
+		DueAt:       dueAt,
+		RemindAt:    remindAt,
+		RemindedAt:  remindedAt,
+		Priority:    Priority(protoTask.Priority),
+		Position:    protoTask.Position,
+		Labels:      fromProtoLabels(protoTask.Labels),
+		ProjectID:   protoTask.ProjectId,
+		ParentID:    protoTask.ParentId,
+		BlockedBy:   protoTask.BlockedBy,
+		Blocked:     protoTask.Blocked,
+		Recurrence:  protoTask.Recurrence,
+		SeriesID:    protoTask.SeriesId,
+		Occurrence:  int(protoTask.Occurrence),
+		Status:      protoTask.Status,
+		CompletedAt: completedAt,
 	}, nil
 }
 
-// FromProtoCreateTaskRequest converts a protobuf CreateTaskRequest to internal type
-func FromProtoCreateTaskRequest(req *pb.CreateTaskRequest) *CreateTaskRequest {
+// parseOptionalTime parses an optional RFC3339 field, recording a violation if it is malformed
+func parseOptionalTime(v *ValidationError, field, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		v.Add(field, ReasonInvalidFormat, fmt.Sprintf("%s must be an RFC3339 timestamp: %v", field, err))
+		return nil
+	}
+	return &t
+}
+
+// FromProtoCreateTaskRequest converts a protobuf CreateTaskRequest to internal type,
+// recording malformed fields in v
+func FromProtoCreateTaskRequest(req *pb.CreateTaskRequest, v *ValidationError) *CreateTaskRequest {
 	return &CreateTaskRequest{
 		Title:       req.Title,
 		Description: req.Description,
+		DueAt:       parseOptionalTime(v, "due_at", req.DueAt),
+		RemindAt:    parseOptionalTime(v, "remind_at", req.RemindAt),
+		Priority:    Priority(req.Priority),
+		ProjectID:   req.ProjectId,
+		ParentID:    req.ParentId,
+		Recurrence:  req.Recurrence,
+		Status:      req.Status,
 	}
 }
 
-// FromProtoUpdateTaskRequest converts a protobuf UpdateTaskRequest to internal type
-func FromProtoUpdateTaskRequest(req *pb.UpdateTaskRequest) *UpdateTaskRequest {
+// FromProtoUpdateTaskRequest converts a protobuf UpdateTaskRequest to internal type,
+// recording malformed fields in v
+func FromProtoUpdateTaskRequest(req *pb.UpdateTaskRequest, v *ValidationError) *UpdateTaskRequest {
 	return &UpdateTaskRequest{
 		ID:          req.Id,
 		Title:       req.Title,
 		Description: req.Description,
 		Completed:   req.Completed,
+		DueAt:       parseOptionalTime(v, "due_at", req.DueAt),
+		RemindAt:    parseOptionalTime(v, "remind_at", req.RemindAt),
+		Priority:    Priority(req.Priority),
+		ParentID:    req.ParentId,
+		Recurrence:  req.Recurrence,
+		Version:     req.Version,
+		UpdateMask:  req.GetUpdateMask().GetPaths(),
+
+		IgnoreBlockers: req.IgnoreBlockers,
+	}
+}
+
+// FromProtoDeleteTaskRequest converts a protobuf DeleteTaskRequest to internal type
+func FromProtoDeleteTaskRequest(req *pb.DeleteTaskRequest) *DeleteTaskRequest {
+	return &DeleteTaskRequest{
+		ID:      req.Id,
+		Version: req.Version,
 	}
 }
 
 // FromProtoListTasksRequest converts a protobuf ListTasksRequest to internal type
-func FromProtoListTasksRequest(req *pb.ListTasksRequest) *ListTasksRequest {
+func FromProtoListTasksRequest(req *pb.ListTasksRequest) (*ListTasksRequest, error) {
+	var v ValidationError
+	orderBy, err := ParseTaskOrder(req.OrderBy)
+	if err != nil {
+		v.Add("order_by", ReasonInvalidFormat, err.Error())
+	}
+
+	taskFilter := TaskFilter{
+		ProjectID:     req.ProjectId,
+		ParentID:      req.ParentId,
+		Completed:     req.Completed,
+		TitleContains: req.TitleContains,
+		Overdue:       req.Overdue,
+		Now:           time.Now(),
+		LabelsAny:     req.LabelsAny,
+		LabelsAll:     req.LabelsAll,
+	}
+	labelSets := []struct {
+		name string
+		ids  []string
+	}{
+		{"labels_any", req.LabelsAny},
+		{"labels_all", req.LabelsAll},
+	}
+	for _, set := range labelSets {
+		if len(set.ids) > MaxLabelsPerRequest {
+			v.Add(set.name, ReasonTooLong, fmt.Sprintf("%s cannot list more than %d labels", set.name, MaxLabelsPerRequest))
+		}
+		if slices.Contains(set.ids, "") {
+			v.Add(set.name, ReasonInvalidFormat, fmt.Sprintf("%s cannot contain an empty label ID", set.name))
+		}
+	}
+	if req.DueWithin != nil {
+		if err := req.DueWithin.CheckValid(); err != nil || req.DueWithin.AsDuration() <= 0 {
+			v.Add("due_within", ReasonInvalidFormat, "due_within must be a positive duration")
+		} else {
+			taskFilter.DueWithin = req.DueWithin.AsDuration()
+		}
+	}
+	bounds := []struct {
+		name  string
+		value string
+		dst   *time.Time
+	}{
+		{"created_after", req.CreatedAfter, &taskFilter.CreatedAfter},
+		{"created_before", req.CreatedBefore, &taskFilter.CreatedBefore},
+		{"updated_after", req.UpdatedAfter, &taskFilter.UpdatedAfter},
+		{"updated_before", req.UpdatedBefore, &taskFilter.UpdatedBefore},
+		{"due_after", req.DueAfter, &taskFilter.DueAfter},
+		{"due_before", req.DueBefore, &taskFilter.DueBefore},
+	}
+	for _, b := range bounds {
+		if b.value == "" {
+			continue
+		}
+		if *b.dst, err = time.Parse(time.RFC3339Nano, b.value); err != nil {
+			v.Add(b.name, ReasonInvalidFormat, fmt.Sprintf("%s must be an RFC3339 timestamp: %v", b.name, err))
+		}
+	}
+
+	expr, err := filter.Compile(req.Filter, TaskSchema)
+	if err != nil {
+		v.Add("filter", ReasonInvalidFormat, fmt.Sprintf("invalid filter: %v", err))
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
 	return &ListTasksRequest{
 		PageToken: req.PageToken,
 		PageSize:  req.PageSize,
-	}
+		Filter:    taskFilter,
+		OrderBy:   orderBy,
+		Expr:      expr,
+	}, nil
 }
 
 // ToProtoCreateTaskResponse converts internal data to protobuf CreateTaskResponse
@@ -87,6 +258,47 @@ func (t *Task) ToProtoUpdateTaskResponse() *pb.UpdateTaskResponse {
 	}
 }
 
+// FromProtoGetTaskTreeRequest converts a protobuf GetTaskTreeRequest to internal type
+func FromProtoGetTaskTreeRequest(req *pb.GetTaskTreeRequest) *GetTaskTreeRequest {
+	return &GetTaskTreeRequest{
+		ID:    req.Id,
+		Depth: int(req.Depth),
+	}
+}
+
+// ToProtoTaskTreeNode converts an internal TaskNode and its subtasks to protobuf
+func (n *TaskNode) ToProtoTaskTreeNode() *pb.TaskTreeNode {
+	subtasks := make([]*pb.TaskTreeNode, len(n.Subtasks))
+	for i, subtask := range n.Subtasks {
+		subtasks[i] = subtask.ToProtoTaskTreeNode()
+	}
+	return &pb.TaskTreeNode{
+		Task:                  n.Task.ToProtoTask(),
+		Subtasks:              subtasks,
+		SubtaskCount:          int32(n.SubtaskCount),
+		CompletedSubtaskCount: int32(n.CompletedSubtasks),
+		Progress:              int32(n.Progress()),
+	}
+}
+
+// FromProtoMoveTaskRequest converts a protobuf MoveTaskRequest to internal type
+func FromProtoMoveTaskRequest(req *pb.MoveTaskRequest) *MoveTaskRequest {
+	return &MoveTaskRequest{
+		ID:        req.Id,
+		AfterID:   req.AfterId,
+		BeforeID:  req.BeforeId,
+		ProjectID: req.ProjectId,
+		Version:   req.Version,
+	}
+}
+
+// ToProtoMoveTaskResponse converts internal Task to protobuf MoveTaskResponse
+func (t *Task) ToProtoMoveTaskResponse() *pb.MoveTaskResponse {
+	return &pb.MoveTaskResponse{
+		Task: t.ToProtoTask(),
+	}
+}
+
 // ToProtoListTasksResponse converts internal ListTasksResponse to protobuf
 func (r *ListTasksResponse) ToProtoListTasksResponse() *pb.ListTasksResponse {
 	protoTasks := make([]*pb.Task, len(r.Tasks))
@@ -105,4 +317,372 @@ func ToProtoDeleteTaskResponse(success bool) *pb.DeleteTaskResponse {
 	return &pb.DeleteTaskResponse{
 		Success: success,
 	}
-}
\ No newline at end of file
+}
+
+// FromProtoSearchTasksRequest converts a protobuf SearchTasksRequest to internal type
+func FromProtoSearchTasksRequest(req *pb.SearchTasksRequest) *SearchTasksRequest {
+	return &SearchTasksRequest{
+		Query:     req.Query,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+	}
+}
+
+// ToProtoSearchTasksResponse converts internal SearchTasksResponse to protobuf
+func (r *SearchTasksResponse) ToProtoSearchTasksResponse() *pb.SearchTasksResponse {
+	results := make([]*pb.SearchResult, len(r.Results))
+	for i, result := range r.Results {
+		results[i] = &pb.SearchResult{
+			Task:               result.Task.ToProtoTask(),
+			Rank:               result.Rank,
+			TitleSnippet:       result.TitleSnippet,
+			DescriptionSnippet: result.DescriptionSnippet,
+		}
+	}
+
+	return &pb.SearchTasksResponse{
+		Results:       results,
+		NextPageToken: r.NextPageToken,
+	}
+}
+
+// ToProtoRestoreTaskResponse converts internal Task to protobuf RestoreTaskResponse
+func (t *Task) ToProtoRestoreTaskResponse() *pb.RestoreTaskResponse {
+	return &pb.RestoreTaskResponse{
+		Task: t.ToProtoTask(),
+	}
+}
+
+// FromProtoListDeletedTasksRequest converts a protobuf ListDeletedTasksRequest to internal type
+func FromProtoListDeletedTasksRequest(req *pb.ListDeletedTasksRequest) *ListDeletedTasksRequest {
+	return &ListDeletedTasksRequest{
+		PageToken: req.PageToken,
+		PageSize:  req.PageSize,
+	}
+}
+
+// ToProtoListDeletedTasksResponse converts internal ListDeletedTasksResponse to protobuf
+func (r *ListDeletedTasksResponse) ToProtoListDeletedTasksResponse() *pb.ListDeletedTasksResponse {
+	protoTasks := make([]*pb.Task, len(r.Tasks))
+	for i, task := range r.Tasks {
+		protoTasks[i] = task.ToProtoTask()
+	}
+
+	return &pb.ListDeletedTasksResponse{
+		Tasks:         protoTasks,
+		NextPageToken: r.NextPageToken,
+	}
+}
+
+// eventTypes maps change types to their protobuf enum values
+var eventTypes = map[string]pb.TaskEvent_Type{
+	ChangeCreated:  pb.TaskEvent_CREATED,
+	ChangeUpdated:  pb.TaskEvent_UPDATED,
+	ChangeDeleted:  pb.TaskEvent_DELETED,
+	ChangeRestored: pb.TaskEvent_RESTORED,
+	ChangeReminded: pb.TaskEvent_REMINDED,
+}
+
+// FromProtoWatchTasksRequest converts a protobuf WatchTasksRequest to internal type
+func FromProtoWatchTasksRequest(req *pb.WatchTasksRequest) (*WatchTasksRequest, error) {
+	var v ValidationError
+	var after int64
+	if req.Cursor != "" {
+		var err error
+		if after, err = strconv.ParseInt(req.Cursor, 10, 64); err != nil || after < 0 {
+			v.Add("cursor", ReasonInvalidFormat, "cursor is not one returned by WatchTasks")
+		}
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	return &WatchTasksRequest{After: after}, nil
+}
+
+// ToProtoTaskEvent converts internal TaskEvent to protobuf TaskEvent
+func (e *TaskEvent) ToProtoTaskEvent() *pb.TaskEvent {
+	return &pb.TaskEvent{
+		Type:       eventTypes[e.Type],
+		Task:       e.Task.ToProtoTask(),
+		Cursor:     strconv.FormatInt(e.Seq, 10),
+		OccurredAt: e.OccurredAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// FromProtoListTaskHistoryRequest converts a protobuf ListTaskHistoryRequest to internal type
+func FromProtoListTaskHistoryRequest(req *pb.ListTaskHistoryRequest) *ListTaskHistoryRequest {
+	return &ListTaskHistoryRequest{
+		TaskID:    req.TaskId,
+		PageToken: req.PageToken,
+		PageSize:  req.PageSize,
+	}
+}
+
+// ToProtoTaskHistoryEntry converts internal TaskHistoryEntry to protobuf TaskHistoryEntry
+func (e *TaskHistoryEntry) ToProtoTaskHistoryEntry() *pb.TaskHistoryEntry {
+	changes := make([]*pb.FieldChange, len(e.Changes))
+	for i, c := range e.Changes {
+		changes[i] = &pb.FieldChange{Field: c.Field, Before: c.Before, After: c.After}
+	}
+	return &pb.TaskHistoryEntry{
+		TaskId:     e.TaskID,
+		Type:       e.Type,
+		Actor:      e.Actor,
+		RequestId:  e.RequestID,
+		Changes:    changes,
+		Version:    e.Version,
+		OccurredAt: e.OccurredAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// ToProtoListTaskHistoryResponse converts internal ListTaskHistoryResponse to protobuf
+func (r *ListTaskHistoryResponse) ToProtoListTaskHistoryResponse() *pb.ListTaskHistoryResponse {
+	entries := make([]*pb.TaskHistoryEntry, len(r.Entries))
+	for i, e := range r.Entries {
+		entries[i] = e.ToProtoTaskHistoryEntry()
+	}
+	return &pb.ListTaskHistoryResponse{
+		Entries:       entries,
+		NextPageToken: r.NextPageToken,
+	}
+}
+
+// FromProtoCreateWebhookRequest converts a protobuf CreateWebhookRequest to internal type
+func FromProtoCreateWebhookRequest(req *pb.CreateWebhookRequest) *CreateWebhookRequest {
+	return &CreateWebhookRequest{
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	}
+}
+
+// ToProtoWebhook converts internal Webhook to protobuf Webhook, leaving out the secret
+func (w *Webhook) ToProtoWebhook() *pb.Webhook {
+	return &pb.Webhook{
+		Id:             w.ID,
+		Url:            w.URL,
+		EventTypes:     w.EventTypes,
+		CreatedAt:      w.CreatedAt.UTC().Format(time.RFC3339Nano),
+		FailureCount:   int32(w.FailureCount),
+		DisabledAt:     formatOptionalTime(w.DisabledAt),
+		DisabledReason: w.DisabledReason,
+	}
+}
+
+// FromProtoListWebhookDeliveriesRequest converts a protobuf ListWebhookDeliveriesRequest to internal type
+func FromProtoListWebhookDeliveriesRequest(req *pb.ListWebhookDeliveriesRequest) *ListWebhookDeliveriesRequest {
+	return &ListWebhookDeliveriesRequest{
+		WebhookID: req.WebhookId,
+		PageToken: req.PageToken,
+		PageSize:  req.PageSize,
+	}
+}
+
+// ToProtoWebhookDelivery converts internal WebhookAttempt to protobuf WebhookDelivery
+func (a *WebhookAttempt) ToProtoWebhookDelivery() *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		DeliveryId:    a.DeliveryID,
+		EventType:     a.EventType,
+		TaskId:        a.TaskID,
+		Attempt:       int32(a.Attempt),
+		StatusCode:    int32(a.StatusCode),
+		Error:         a.Error,
+		DurationMs:    a.Duration.Milliseconds(),
+		AttemptedAt:   a.AttemptedAt.UTC().Format(time.RFC3339Nano),
+		NextAttemptAt: formatOptionalTime(a.NextAttemptAt),
+	}
+}
+
+// ToProtoListWebhookDeliveriesResponse converts internal ListWebhookDeliveriesResponse to protobuf
+func (r *ListWebhookDeliveriesResponse) ToProtoListWebhookDeliveriesResponse() *pb.ListWebhookDeliveriesResponse {
+	deliveries := make([]*pb.WebhookDelivery, len(r.Attempts))
+	for i, a := range r.Attempts {
+		deliveries[i] = a.ToProtoWebhookDelivery()
+	}
+	return &pb.ListWebhookDeliveriesResponse{
+		Deliveries:    deliveries,
+		NextPageToken: r.NextPageToken,
+	}
+}
+
+// ToProtoLabel converts an internal Label to a protobuf Label
+func (l *Label) ToProtoLabel() *pb.Label {
+	var createdAt string
+	if !l.CreatedAt.IsZero() {
+		createdAt = l.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return &pb.Label{
+		Id:        l.ID,
+		Name:      l.Name,
+		Color:     l.Color,
+		CreatedAt: createdAt,
+	}
+}
+
+// toProtoLabels converts a task's labels
+func toProtoLabels(labels LabelList) []*pb.Label {
+	protoLabels := make([]*pb.Label, len(labels))
+	for i := range labels {
+		protoLabels[i] = labels[i].ToProtoLabel()
+	}
+	return protoLabels
+}
+
+// fromProtoLabels converts the labels of a protobuf Task
+func fromProtoLabels(protoLabels []*pb.Label) LabelList {
+	if len(protoLabels) == 0 {
+		return nil
+	}
+	labels := make(LabelList, len(protoLabels))
+	for i, l := range protoLabels {
+		labels[i] = Label{ID: l.Id, Name: l.Name, Color: l.Color}
+	}
+	return labels
+}
+
+// FromProtoCreateLabelRequest converts a protobuf CreateLabelRequest to internal type
+func FromProtoCreateLabelRequest(req *pb.CreateLabelRequest) *CreateLabelRequest {
+	return &CreateLabelRequest{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+}
+
+// FromProtoUpdateLabelRequest converts a protobuf UpdateLabelRequest to internal type
+func FromProtoUpdateLabelRequest(req *pb.UpdateLabelRequest) *UpdateLabelRequest {
+	return &UpdateLabelRequest{
+		ID:         req.Id,
+		Name:       req.Name,
+		Color:      req.Color,
+		UpdateMask: req.GetUpdateMask().GetPaths(),
+	}
+}
+
+// FromProtoAddTaskLabelsRequest converts a protobuf AddTaskLabelsRequest to internal type
+func FromProtoAddTaskLabelsRequest(req *pb.AddTaskLabelsRequest) *TaskLabelsRequest {
+	return &TaskLabelsRequest{
+		TaskID:   req.TaskId,
+		LabelIDs: req.LabelIds,
+		Version:  req.Version,
+	}
+}
+
+// FromProtoRemoveTaskLabelsRequest converts a protobuf RemoveTaskLabelsRequest to internal type
+func FromProtoRemoveTaskLabelsRequest(req *pb.RemoveTaskLabelsRequest) *TaskLabelsRequest {
+	return &TaskLabelsRequest{
+		TaskID:   req.TaskId,
+		LabelIDs: req.LabelIds,
+		Version:  req.Version,
+	}
+}
+
+// ToProtoProject converts an internal Project to a protobuf Project
+func (p *Project) ToProtoProject() *pb.Project {
+	return &pb.Project{
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:   p.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// FromProtoCreateProjectRequest converts a protobuf CreateProjectRequest to internal type
+func FromProtoCreateProjectRequest(req *pb.CreateProjectRequest) *CreateProjectRequest {
+	return &CreateProjectRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+// FromProtoUpdateProjectRequest converts a protobuf UpdateProjectRequest to internal type
+func FromProtoUpdateProjectRequest(req *pb.UpdateProjectRequest) *UpdateProjectRequest {
+	return &UpdateProjectRequest{
+		ID:          req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+		UpdateMask:  req.GetUpdateMask().GetPaths(),
+	}
+}
+
+// FromProtoDeleteProjectRequest converts a protobuf DeleteProjectRequest to internal type
+func FromProtoDeleteProjectRequest(req *pb.DeleteProjectRequest) *DeleteProjectRequest {
+	return &DeleteProjectRequest{
+		ID:    req.Id,
+		Tasks: ProjectDeletion(req.Tasks),
+	}
+}
+
+// FromProtoAddDependencyRequest converts a protobuf AddDependencyRequest to internal type
+func FromProtoAddDependencyRequest(req *pb.AddDependencyRequest) *DependencyRequest {
+	return &DependencyRequest{
+		TaskID:    req.TaskId,
+		BlockerID: req.BlockerId,
+		Version:   req.Version,
+	}
+}
+
+// FromProtoRemoveDependencyRequest converts a protobuf RemoveDependencyRequest to internal type
+func FromProtoRemoveDependencyRequest(req *pb.RemoveDependencyRequest) *DependencyRequest {
+	return &DependencyRequest{
+		TaskID:    req.TaskId,
+		BlockerID: req.BlockerId,
+		Version:   req.Version,
+	}
+}
+
+// FromProtoListTasksInDependencyOrderRequest converts a protobuf ListTasksInDependencyOrderRequest to internal type
+func FromProtoListTasksInDependencyOrderRequest(req *pb.ListTasksInDependencyOrderRequest) *ListTasksInDependencyOrderRequest {
+	return &ListTasksInDependencyOrderRequest{
+		ProjectID:        req.ProjectId,
+		IncludeCompleted: req.IncludeCompleted,
+	}
+}
+
+// FromProtoPreviewOccurrencesRequest converts a protobuf PreviewOccurrencesRequest to internal type
+func FromProtoPreviewOccurrencesRequest(req *pb.PreviewOccurrencesRequest) (*PreviewOccurrencesRequest, error) {
+	var v ValidationError
+	previewReq := &PreviewOccurrencesRequest{
+		TaskID:     req.TaskId,
+		Recurrence: req.Recurrence,
+		DueAt:      parseOptionalTime(&v, "due_at", req.DueAt),
+		Limit:      int(req.Limit),
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	return previewReq, nil
+}
+
+// ToProtoPreviewOccurrencesResponse converts due dates to a protobuf PreviewOccurrencesResponse
+func ToProtoPreviewOccurrencesResponse(dueAt []time.Time) *pb.PreviewOccurrencesResponse {
+	formatted := make([]string, len(dueAt))
+	for i := range dueAt {
+		formatted[i] = formatOptionalTime(&dueAt[i])
+	}
+	return &pb.PreviewOccurrencesResponse{DueAt: formatted}
+}
+
+// FromProtoTransitionTaskRequest converts a protobuf TransitionTaskRequest to internal type
+func FromProtoTransitionTaskRequest(req *pb.TransitionTaskRequest) *TransitionTaskRequest {
+	return &TransitionTaskRequest{
+		ID:             req.Id,
+		Status:         req.Status,
+		Version:        req.Version,
+		IgnoreBlockers: req.IgnoreBlockers,
+	}
+}
+
+// ToProtoGetWorkflowResponse converts a Workflow to a protobuf GetWorkflowResponse
+func (w *Workflow) ToProtoGetWorkflowResponse() *pb.GetWorkflowResponse {
+	statuses := w.Statuses()
+	resp := &pb.GetWorkflowResponse{Statuses: make([]*pb.WorkflowStatus, len(statuses))}
+	for i, status := range statuses {
+		resp.Statuses[i] = &pb.WorkflowStatus{
+			Name:        status,
+			Done:        w.IsDone(status),
+			Transitions: w.Targets(status),
+		}
+	}
+	return resp
+}
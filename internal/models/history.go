@@ -0,0 +1,121 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChangePurged marks a task permanently removed from the trash; it appears only in history
+const ChangePurged = "purged"
+
+// FieldChange records one field's value before and after a change, rendered as text.
+// Before is empty for tasks being created.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// TaskHistoryEntry is one audited change to a task
+type TaskHistoryEntry struct {
+	ID         int64         `json:"id"`
+	TaskID     string        `json:"task_id"`
+	Type       string        `json:"type"` // one of the Change* constants
+	Actor      string        `json:"actor"`
+	RequestID  string        `json:"request_id"`
+	Changes    []FieldChange `json:"changes"`
+	Version    int64         `json:"version"` // task version after the change
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+// NewTaskHistoryEntry describes the change from before to after; before is nil for creates
+func NewTaskHistoryEntry(kind, actor, requestID string, before, after *Task) *TaskHistoryEntry {
+	return &TaskHistoryEntry{
+		TaskID:    after.ID,
+		Type:      kind,
+		Actor:     actor,
+		RequestID: requestID,
+		Changes:   DiffTasks(before, after),
+		Version:   after.Version,
+	}
+}
+
+// DiffTasks lists the user-visible fields that differ between before and after.
+// A nil before is treated as an empty task, so creates list every set field.
+func DiffTasks(before, after *Task) []FieldChange {
+	if before == nil {
+		before = &Task{}
+	}
+
+	changes := []FieldChange{}
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, FieldChange{Field: field, Before: from, After: to})
+		}
+	}
+	add(FieldTitle, before.Title, after.Title)
+	add(FieldDescription, before.Description, after.Description)
+	if before.Completed != after.Completed {
+		add(FieldCompleted, strconv.FormatBool(before.Completed), strconv.FormatBool(after.Completed))
+	}
+	add(FieldStatus, before.Status, after.Status)
+	add(FieldDueAt, formatOptionalTime(before.DueAt), formatOptionalTime(after.DueAt))
+	add(FieldRemindAt, formatOptionalTime(before.RemindAt), formatOptionalTime(after.RemindAt))
+	add(FieldPriority, before.Priority.String(), after.Priority.String())
+	add(FieldProjectID, before.ProjectID, after.ProjectID)
+	add(FieldParentID, before.ParentID, after.ParentID)
+	add(FieldPosition, before.Position, after.Position)
+	add(FieldLabels, before.Labels.names(), after.Labels.names())
+	add(FieldBlockedBy, strings.Join(before.BlockedBy, ", "), strings.Join(after.BlockedBy, ", "))
+	add(FieldRecurrence, before.Recurrence, after.Recurrence)
+	add("deleted_at", formatOptionalTime(before.DeletedAt), formatOptionalTime(after.DeletedAt))
+	return changes
+}
+
+// formatOptionalTime renders t as RFC3339 in UTC, or "" when it is nil
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// HistoryCursor identifies the last entry of a history page in id descending order
+type HistoryCursor struct {
+	ID int64 `json:"id"`
+}
+
+// ListTaskHistoryRequest represents the internal request for a task's history
+type ListTaskHistoryRequest struct {
+	TaskID    string         `json:"task_id"`
+	PageToken string         `json:"page_token"`
+	PageSize  int32          `json:"page_size"`
+	Cursor    *HistoryCursor `json:"-"` // decoded from PageToken; nil for the first page
+}
+
+// Validate validates the list task history request
+func (r *ListTaskHistoryRequest) Validate() error {
+	var v ValidationError
+	if r.TaskID == "" {
+		v.Add("task_id", ReasonRequired, "task_id cannot be empty")
+	}
+	return v.Err()
+}
+
+// ListTaskHistoryResponse represents the internal response for a task's history
+type ListTaskHistoryResponse struct {
+	Entries       []*TaskHistoryEntry `json:"entries"`
+	NextPageToken string              `json:"next_page_token"`
+	NextCursor    *HistoryCursor      `json:"-"` // nil when there are no more pages
+}
+
+// NewListTaskHistoryResponse builds a page from up to pageSize+1 entries, newest first
+func NewListTaskHistoryResponse(entries []*TaskHistoryEntry, pageSize int32) *ListTaskHistoryResponse {
+	resp := &ListTaskHistoryResponse{Entries: entries}
+	if len(entries) > int(pageSize) {
+		resp.Entries = entries[:pageSize]
+		resp.NextCursor = &HistoryCursor{ID: resp.Entries[len(resp.Entries)-1].ID}
+	}
+	return resp
+}
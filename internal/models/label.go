@@ -0,0 +1,209 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Label fields that can be named in an update mask
+const (
+	FieldLabelName  = "name"
+	FieldLabelColor = "color"
+)
+
+// FieldLabels is the task's label set in history; only the label RPCs change it
+const FieldLabels = "labels"
+
+// Limits on labels
+const (
+	MaxLabelNameLength  = 64
+	MaxLabelsPerRequest = 100
+	DefaultLabelColor   = "#808080"
+)
+
+// labelColorPattern matches a #RRGGBB color
+var labelColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Label is a named, colored tag that can be attached to any number of tasks
+type Label struct {
+	ID        string    `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"` // unique, ignoring case
+	Color     string    `json:"color" db:"color"`
+	CreatedAt time.Time `json:"created_at,omitzero" db:"created_at"` // not set on a task's labels
+}
+
+// LabelList is a task's labels, ordered by name. It scans from the JSON array the
+// database builds for each task.
+type LabelList []Label
+
+// Scan implements sql.Scanner for a JSON array of labels
+func (l *LabelList) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into LabelList", src)
+	}
+	return json.Unmarshal(data, l)
+}
+
+// IDs returns the IDs of the labels
+func (l LabelList) IDs() []string {
+	ids := make([]string, len(l))
+	for i, label := range l {
+		ids[i] = label.ID
+	}
+	return ids
+}
+
+// names renders the label names for task history
+func (l LabelList) names() string {
+	names := make([]string, len(l))
+	for i, label := range l {
+		names[i] = label.Name
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// SortLabels orders labels by name, then ID
+func SortLabels(labels []Label) {
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].Name != labels[j].Name {
+			return labels[i].Name < labels[j].Name
+		}
+		return labels[i].ID < labels[j].ID
+	})
+}
+
+// CreateLabelRequest represents the internal request for creating a label
+type CreateLabelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // DefaultLabelColor when empty
+}
+
+// Validate validates the create label request
+func (r *CreateLabelRequest) Validate() error {
+	var v ValidationError
+	validateLabelName(&v, r.Name)
+	if r.Color != "" {
+		validateLabelColor(&v, r.Color)
+	}
+	return v.Err()
+}
+
+// UpdateLabelRequest represents the internal request for renaming or recoloring a label
+type UpdateLabelRequest struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Color      string   `json:"color"`
+	UpdateMask []string `json:"update_mask"` // fields to change; empty means both
+}
+
+// Fields returns the fields this request changes
+func (r *UpdateLabelRequest) Fields() []string {
+	if len(r.UpdateMask) == 0 {
+		return []string{FieldLabelName, FieldLabelColor}
+	}
+	return r.UpdateMask
+}
+
+// Validate validates the update label request
+func (r *UpdateLabelRequest) Validate() error {
+	var v ValidationError
+	if r.ID == "" {
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, field := range r.Fields() {
+		if seen[field] {
+			v.Add("update_mask", ReasonDuplicate, fmt.Sprintf("update_mask lists %q more than once", field))
+			continue
+		}
+		seen[field] = true
+
+		switch field {
+		case FieldLabelName:
+			validateLabelName(&v, r.Name)
+		case FieldLabelColor:
+			validateLabelColor(&v, r.Color)
+		default:
+			v.Add("update_mask", ReasonUnknownField, fmt.Sprintf("update_mask contains unknown field %q", field))
+		}
+	}
+	return v.Err()
+}
+
+// ApplyTo copies the masked fields of the request onto label
+func (r *UpdateLabelRequest) ApplyTo(label *Label) {
+	for _, field := range r.Fields() {
+		switch field {
+		case FieldLabelName:
+			label.Name = r.Name
+		case FieldLabelColor:
+			label.Color = r.Color
+		}
+	}
+}
+
+// TaskLabelsRequest adds labels to or removes them from a task
+type TaskLabelsRequest struct {
+	TaskID   string   `json:"task_id"`
+	LabelIDs []string `json:"label_ids"`
+	Version  int64    `json:"version"` // expected current version; 0 skips the check
+}
+
+// Validate validates the task labels request
+func (r *TaskLabelsRequest) Validate() error {
+	var v ValidationError
+	if r.TaskID == "" {
+		v.Add("task_id", ReasonRequired, "task_id cannot be empty")
+	}
+	switch {
+	case len(r.LabelIDs) == 0:
+		v.Add("label_ids", ReasonRequired, "label_ids cannot be empty")
+	case len(r.LabelIDs) > MaxLabelsPerRequest:
+		v.Add("label_ids", ReasonTooLong, fmt.Sprintf("label_ids cannot list more than %d labels", MaxLabelsPerRequest))
+	}
+	for i, id := range r.LabelIDs {
+		field := fmt.Sprintf("label_ids[%d]", i)
+		switch {
+		case id == "":
+			v.Add(field, ReasonRequired, "label ID cannot be empty")
+		case slices.Contains(r.LabelIDs[:i], id):
+			v.Add(field, ReasonDuplicate, fmt.Sprintf("label %q is listed more than once", id))
+		}
+	}
+	return v.Err()
+}
+
+// validateLabelName checks a required label name
+func validateLabelName(v *ValidationError, name string) {
+	switch {
+	case strings.TrimSpace(name) == "":
+		v.Add(FieldLabelName, ReasonRequired, "name cannot be empty")
+	case name != strings.TrimSpace(name):
+		v.Add(FieldLabelName, ReasonInvalidFormat, "name cannot start or end with spaces")
+	case len(name) > MaxLabelNameLength:
+		v.Add(FieldLabelName, ReasonTooLong, fmt.Sprintf("name cannot exceed %d characters", MaxLabelNameLength))
+	}
+}
+
+// validateLabelColor checks a #RRGGBB color
+func validateLabelColor(v *ValidationError, color string) {
+	if !labelColorPattern.MatchString(color) {
+		v.Add(FieldLabelColor, ReasonInvalidFormat, "color must be a #RRGGBB hex color")
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+// MoveTaskRequest places a task in the manual order of its project: right after
+// AfterID, right before BeforeID, or between the two when both are set. With ProjectID
+// the task moves to that project, at the end unless a neighbour is given.
+type MoveTaskRequest struct {
+	ID        string `json:"id"`
+	AfterID   string `json:"after_id"`
+	BeforeID  string `json:"before_id"`
+	ProjectID string `json:"project_id"` // empty keeps the task's project
+	Version   int64  `json:"version"`    // expected current version; 0 skips the check
+}
+
+// Validate validates the move task request
+func (r *MoveTaskRequest) Validate() error {
+	var v ValidationError
+	if r.ID == "" {
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	}
+	if r.AfterID == "" && r.BeforeID == "" && r.ProjectID == "" {
+		v.Add("after_id", ReasonRequired, "after_id, before_id or project_id must be set")
+	}
+	if r.ID != "" && r.AfterID == r.ID {
+		v.Add("after_id", ReasonInvalidFormat, "a task cannot be moved after itself")
+	}
+	if r.ID != "" && r.BeforeID == r.ID {
+		v.Add("before_id", ReasonInvalidFormat, "a task cannot be moved before itself")
+	}
+	if r.AfterID != "" && r.AfterID == r.BeforeID {
+		v.Add("before_id", ReasonInvalidFormat, "after_id and before_id must differ")
+	}
+	return v.Err()
+}
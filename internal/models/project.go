@@ -0,0 +1,152 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Project fields that can be named in an update mask
+const (
+	FieldProjectName        = "name"
+	FieldProjectDescription = "description"
+)
+
+// FieldProjectID is the project a task belongs to; only CreateTask and MoveTask set it
+const FieldProjectID = "project_id"
+
+// DefaultProjectID is the project tasks go to when none is given. It always exists and
+// cannot be deleted.
+const DefaultProjectID = "default"
+
+// DefaultProjectName is the initial name of the default project
+const DefaultProjectName = "Inbox"
+
+// MaxProjectNameLength bounds project names
+const MaxProjectNameLength = 255
+
+// Project groups tasks; every task belongs to exactly one
+type Project struct {
+	ID          string    `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ProjectDeletion is what happens to the tasks of a deleted project
+type ProjectDeletion int32
+
+const (
+	// ProjectDeletionRestrict refuses to delete a project that still has live tasks
+	ProjectDeletionRestrict ProjectDeletion = iota
+	// ProjectDeletionMove moves the live tasks to the end of the default project
+	ProjectDeletionMove
+	// ProjectDeletionTrash moves the live tasks to the trash
+	ProjectDeletionTrash
+)
+
+// Valid reports whether d is one of the defined deletion modes
+func (d ProjectDeletion) Valid() bool {
+	return d >= ProjectDeletionRestrict && d <= ProjectDeletionTrash
+}
+
+// CreateProjectRequest represents the internal request for creating a project
+type CreateProjectRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Validate validates the create project request
+func (r *CreateProjectRequest) Validate() error {
+	var v ValidationError
+	validateProjectName(&v, r.Name)
+	validateDescription(&v, r.Description)
+	return v.Err()
+}
+
+// UpdateProjectRequest represents the internal request for updating a project
+type UpdateProjectRequest struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	UpdateMask  []string `json:"update_mask"` // fields to change; empty means both
+}
+
+// Fields returns the fields this request changes
+func (r *UpdateProjectRequest) Fields() []string {
+	if len(r.UpdateMask) == 0 {
+		return []string{FieldProjectName, FieldProjectDescription}
+	}
+	return r.UpdateMask
+}
+
+// Validate validates the update project request
+func (r *UpdateProjectRequest) Validate() error {
+	var v ValidationError
+	if r.ID == "" {
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, field := range r.Fields() {
+		if seen[field] {
+			v.Add("update_mask", ReasonDuplicate, fmt.Sprintf("update_mask lists %q more than once", field))
+			continue
+		}
+		seen[field] = true
+
+		switch field {
+		case FieldProjectName:
+			validateProjectName(&v, r.Name)
+		case FieldProjectDescription:
+			validateDescription(&v, r.Description)
+		default:
+			v.Add("update_mask", ReasonUnknownField, fmt.Sprintf("update_mask contains unknown field %q", field))
+		}
+	}
+	return v.Err()
+}
+
+// ApplyTo copies the masked fields of the request onto project
+func (r *UpdateProjectRequest) ApplyTo(project *Project) {
+	for _, field := range r.Fields() {
+		switch field {
+		case FieldProjectName:
+			project.Name = r.Name
+		case FieldProjectDescription:
+			project.Description = r.Description
+		}
+	}
+}
+
+// DeleteProjectRequest represents the internal request for deleting a project
+type DeleteProjectRequest struct {
+	ID    string          `json:"id"`
+	Tasks ProjectDeletion `json:"tasks"` // what happens to the project's live tasks
+}
+
+// Validate validates the delete project request
+func (r *DeleteProjectRequest) Validate() error {
+	var v ValidationError
+	switch r.ID {
+	case "":
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	case DefaultProjectID:
+		v.Add("id", ReasonInvalidFormat, "the default project cannot be deleted")
+	}
+	if !r.Tasks.Valid() {
+		v.Add("tasks", ReasonInvalidFormat, fmt.Sprintf("deletion mode %d is not defined", int32(r.Tasks)))
+	}
+	return v.Err()
+}
+
+// validateProjectName checks a required project name
+func validateProjectName(v *ValidationError, name string) {
+	switch {
+	case strings.TrimSpace(name) == "":
+		v.Add(FieldProjectName, ReasonRequired, "name cannot be empty")
+	case len(name) > MaxProjectNameLength:
+		v.Add(FieldProjectName, ReasonTooLong, fmt.Sprintf("name cannot exceed %d characters", MaxProjectNameLength))
+	}
+}
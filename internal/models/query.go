@@ -0,0 +1,361 @@
+package models
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/filter"
+)
+
+// Fields ListTasks can be ordered by
+const (
+	OrderByCreatedAt = "created_at"
+	OrderByUpdatedAt = "updated_at"
+	OrderByTitle     = "title"
+	OrderByPriority  = "priority"
+	OrderByPosition  = "position" // the manual order set with MoveTask
+)
+
+// Page size limits shared by every paginated RPC
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// NormalizePageSize applies the default to sizes that are unset or out of range
+func NormalizePageSize(size int32) int32 {
+	if size <= 0 || size > MaxPageSize {
+		return DefaultPageSize
+	}
+	return size
+}
+
+// TaskSchema lists the task fields usable in filter expressions
+var TaskSchema = filter.Schema{
+	"id":          filter.TypeString,
+	"title":       filter.TypeString,
+	"description": filter.TypeString,
+	"completed":   filter.TypeBool,
+	"status":      filter.TypeString,
+	"created_at":  filter.TypeTimestamp,
+	"updated_at":  filter.TypeTimestamp,
+	"version":     filter.TypeInt,
+	"priority":    filter.TypeInt,
+	"project_id":  filter.TypeString,
+	"parent_id":   filter.TypeString,
+	"recurrence":  filter.TypeString,
+	"series_id":   filter.TypeString,
+}
+
+// FieldValue implements filter.Record for the fields in TaskSchema
+func (t *Task) FieldValue(field string) interface{} {
+	switch field {
+	case "id":
+		return t.ID
+	case "title":
+		return t.Title
+	case "description":
+		return t.Description
+	case "completed":
+		return t.Completed
+	case "status":
+		return t.Status
+	case "created_at":
+		return t.CreatedAt
+	case "updated_at":
+		return t.UpdatedAt
+	case "version":
+		return t.Version
+	case "priority":
+		return int64(t.Priority)
+	case "project_id":
+		return t.ProjectID
+	case "parent_id":
+		return t.ParentID
+	case "recurrence":
+		return t.Recurrence
+	case "series_id":
+		return t.SeriesID
+	default:
+		return nil
+	}
+}
+
+// TaskFilter restricts which tasks ListTasks returns; zero values match everything
+type TaskFilter struct {
+	ProjectID     string        `json:"project_id,omitempty"`
+	ParentID      string        `json:"parent_id,omitempty"` // direct subtasks of this task
+	Completed     *bool         `json:"completed,omitempty"`
+	CreatedAfter  time.Time     `json:"created_after,omitempty"`  // inclusive
+	CreatedBefore time.Time     `json:"created_before,omitempty"` // exclusive
+	UpdatedAfter  time.Time     `json:"updated_after,omitempty"`  // inclusive
+	UpdatedBefore time.Time     `json:"updated_before,omitempty"` // exclusive
+	TitleContains string        `json:"title_contains,omitempty"` // case-insensitive
+	DueAfter      time.Time     `json:"due_after,omitempty"`      // inclusive
+	DueBefore     time.Time     `json:"due_before,omitempty"`     // exclusive
+	Overdue       bool          `json:"overdue,omitempty"`        // incomplete and due before Now
+	DueWithin     time.Duration `json:"due_within,omitempty"`     // due in [Now, Now+DueWithin)
+	Now           time.Time     `json:"-"`                        // reference time for Overdue and DueWithin
+	LabelsAny     []string      `json:"labels_any,omitempty"`     // label IDs; the task has at least one
+	LabelsAll     []string      `json:"labels_all,omitempty"`     // label IDs; the task has every one
+}
+
+// HasDeadline reports whether the filter only matches tasks with a due_at
+func (f *TaskFilter) HasDeadline() bool {
+	return !f.DueAfter.IsZero() || !f.DueBefore.IsZero() || f.Overdue || f.DueWithin > 0
+}
+
+// Matches reports whether task passes the filter
+func (f *TaskFilter) Matches(task *Task) bool {
+	if f.ProjectID != "" && task.ProjectID != f.ProjectID {
+		return false
+	}
+	if f.ParentID != "" && task.ParentID != f.ParentID {
+		return false
+	}
+	if f.Completed != nil && task.Completed != *f.Completed {
+		return false
+	}
+	if !inRange(task.CreatedAt, f.CreatedAfter, f.CreatedBefore) ||
+		!inRange(task.UpdatedAt, f.UpdatedAfter, f.UpdatedBefore) {
+		return false
+	}
+	if f.TitleContains != "" && !strings.Contains(strings.ToLower(task.Title), strings.ToLower(f.TitleContains)) {
+		return false
+	}
+	if len(f.LabelsAny) > 0 || len(f.LabelsAll) > 0 {
+		ids := task.Labels.IDs()
+		has := func(id string) bool { return slices.Contains(ids, id) }
+		if len(f.LabelsAny) > 0 && !slices.ContainsFunc(f.LabelsAny, has) {
+			return false
+		}
+		for _, id := range f.LabelsAll {
+			if !has(id) {
+				return false
+			}
+		}
+	}
+
+	if !f.HasDeadline() {
+		return true
+	}
+	if task.DueAt == nil || !inRange(*task.DueAt, f.DueAfter, f.DueBefore) {
+		return false
+	}
+	if f.Overdue && (task.Completed || !task.DueAt.Before(f.Now)) {
+		return false
+	}
+	if f.DueWithin > 0 && !inRange(*task.DueAt, f.Now, f.Now.Add(f.DueWithin)) {
+		return false
+	}
+	return true
+}
+
+// inRange reports whether t lies in [after, before), treating zero bounds as open
+func inRange(t, after, before time.Time) bool {
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+	if !before.IsZero() && !t.Before(before) {
+		return false
+	}
+	return true
+}
+
+// TaskOrder is the sort order of ListTasks; ties are broken by ID in the same direction
+type TaskOrder struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc"`
+}
+
+// DefaultTaskOrder lists the newest tasks first
+var DefaultTaskOrder = TaskOrder{Field: OrderByCreatedAt, Desc: true}
+
+// ParseTaskOrder parses an order_by string such as "updated_at desc"
+func ParseTaskOrder(orderBy string) (TaskOrder, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return DefaultTaskOrder, nil
+	}
+	if len(parts) > 2 {
+		return TaskOrder{}, fmt.Errorf("order_by must be a single field with optional direction, got %q", orderBy)
+	}
+
+	order := TaskOrder{Field: parts[0]}
+	switch order.Field {
+	case OrderByCreatedAt, OrderByUpdatedAt, OrderByTitle, OrderByPriority, OrderByPosition:
+	default:
+		return TaskOrder{}, fmt.Errorf("cannot order by %q", order.Field)
+	}
+
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return TaskOrder{}, fmt.Errorf("invalid order direction %q", parts[1])
+		}
+	}
+	return order, nil
+}
+
+// compare orders two tasks by the sort field then ID, ignoring direction
+func (o TaskOrder) compare(a, b *Task) int {
+	var c int
+	switch o.Field {
+	case OrderByUpdatedAt:
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	case OrderByTitle:
+		c = strings.Compare(a.Title, b.Title)
+	case OrderByPriority:
+		c = cmp.Compare(a.Priority, b.Priority)
+	case OrderByPosition:
+		c = strings.Compare(a.Position, b.Position)
+	default:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c == 0 {
+		c = strings.Compare(a.ID, b.ID)
+	}
+	return c
+}
+
+// Less reports whether a is listed before b
+func (o TaskOrder) Less(a, b *Task) bool {
+	if o.Desc {
+		return o.compare(a, b) > 0
+	}
+	return o.compare(a, b) < 0
+}
+
+// After reports whether task is listed after the cursor position
+func (o TaskOrder) After(task *Task, cursor *PageCursor) bool {
+	return o.Less(cursor.task(), task)
+}
+
+// PageCursor holds the sort keys of the last task of a page
+type PageCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Title     string    `json:"title"`
+	Priority  Priority  `json:"priority"`
+	Position  string    `json:"position"`
+	ID        string    `json:"id"`
+	AsOf      time.Time `json:"as_of"` // TaskFilter.Now of the first page, so relative filters stay fixed
+}
+
+// task returns a Task carrying the cursor's sort keys
+func (c *PageCursor) task() *Task {
+	return &Task{ID: c.ID, Title: c.Title, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt,
+		Priority: c.Priority, Position: c.Position}
+}
+
+// Value returns the cursor's key for the given sort field
+func (c *PageCursor) Value(field string) interface{} {
+	switch field {
+	case OrderByUpdatedAt:
+		return c.UpdatedAt
+	case OrderByTitle:
+		return c.Title
+	case OrderByPriority:
+		return c.Priority
+	case OrderByPosition:
+		return c.Position
+	default:
+		return c.CreatedAt
+	}
+}
+
+// ListTasksRequest represents the internal request for listing tasks
+type ListTasksRequest struct {
+	PageToken string      `json:"page_token"`
+	PageSize  int32       `json:"page_size"`
+	Filter    TaskFilter  `json:"filter"`
+	OrderBy   TaskOrder   `json:"order_by"`
+	Expr      filter.Expr `json:"-"` // checked filter expression; nil matches everything
+	Cursor    *PageCursor `json:"-"` // decoded from PageToken; nil for the first page
+}
+
+// Matches reports whether task passes both the fixed filters and the expression
+func (r *ListTasksRequest) Matches(task *Task) bool {
+	return r.Filter.Matches(task) && (r.Expr == nil || filter.Eval(r.Expr, task))
+}
+
+// Order returns the requested order, defaulting to DefaultTaskOrder
+func (r *ListTasksRequest) Order() TaskOrder {
+	if r.OrderBy.Field == "" {
+		return DefaultTaskOrder
+	}
+	return r.OrderBy
+}
+
+// QueryKey fingerprints the filters and order so a page token cannot be replayed
+// against a different query
+func (r *ListTasksRequest) QueryKey() string {
+	f := r.Filter
+	completed := "any"
+	if f.Completed != nil {
+		completed = fmt.Sprint(*f.Completed)
+	}
+
+	key := strings.Join([]string{
+		f.ProjectID, f.ParentID, completed,
+		f.CreatedAfter.UTC().Format(time.RFC3339Nano), f.CreatedBefore.UTC().Format(time.RFC3339Nano),
+		f.UpdatedAfter.UTC().Format(time.RFC3339Nano), f.UpdatedBefore.UTC().Format(time.RFC3339Nano),
+		f.TitleContains,
+		f.DueAfter.UTC().Format(time.RFC3339Nano), f.DueBefore.UTC().Format(time.RFC3339Nano),
+		fmt.Sprint(f.Overdue), f.DueWithin.String(),
+		labelSetKey(f.LabelsAny), labelSetKey(f.LabelsAll),
+		r.Order().Field, fmt.Sprint(r.Order().Desc),
+		exprString(r.Expr),
+	}, "\x00")
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
+
+// labelSetKey renders a set of label IDs independently of their order
+func labelSetKey(ids []string) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// exprString renders an optional expression in canonical form
+func exprString(expr filter.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	return expr.String()
+}
+
+// ListTasksResponse represents the internal response for listing tasks
+type ListTasksResponse struct {
+	Tasks         []*Task     `json:"tasks"`
+	NextPageToken string      `json:"next_page_token"`
+	NextCursor    *PageCursor `json:"-"` // nil when there are no more pages
+}
+
+// NewListTasksResponse builds a page from up to pageSize+1 ordered tasks.
+// The extra task, if present, only signals that a next page exists.
+func NewListTasksResponse(tasks []*Task, pageSize int32) *ListTasksResponse {
+	resp := &ListTasksResponse{Tasks: tasks}
+	if len(tasks) > int(pageSize) {
+		resp.Tasks = tasks[:pageSize]
+		last := resp.Tasks[len(resp.Tasks)-1]
+		resp.NextCursor = &PageCursor{
+			CreatedAt: last.CreatedAt,
+			UpdatedAt: last.UpdatedAt,
+			Title:     last.Title,
+			Priority:  last.Priority,
+			Position:  last.Position,
+			ID:        last.ID,
+		}
+	}
+	return resp
+}
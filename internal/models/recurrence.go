@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/recurrence"
+)
+
+// Bounds for PreviewOccurrencesRequest.Limit
+const (
+	DefaultPreviewOccurrences = 10
+	MaxPreviewOccurrences     = 100
+)
+
+// PreviewOccurrencesRequest asks for the due dates of the occurrences that would follow
+// a recurring task, or a task with a rule that is not saved yet
+type PreviewOccurrencesRequest struct {
+	TaskID     string     `json:"task_id"`
+	Recurrence string     `json:"recurrence"`       // previews a new task with this rule instead of TaskID
+	DueAt      *time.Time `json:"due_at,omitempty"` // the new task's deadline
+	Limit      int        `json:"limit"`            // DefaultPreviewOccurrences when 0
+}
+
+// Validate validates the preview occurrences request
+func (r *PreviewOccurrencesRequest) Validate() error {
+	var v ValidationError
+	switch {
+	case r.TaskID == "" && r.Recurrence == "":
+		v.Add("task_id", ReasonRequired, "one of task_id and recurrence is required")
+	case r.TaskID != "" && r.Recurrence != "":
+		v.Add("recurrence", ReasonInvalidFormat, "task_id and recurrence cannot both be set")
+	}
+	validateRecurrence(&v, r.Recurrence)
+	if r.Limit < 0 || r.Limit > MaxPreviewOccurrences {
+		v.Add("limit", ReasonInvalidFormat, "limit must be between 0 and 100")
+	}
+	return v.Err()
+}
+
+// PreviewLimit returns the number of occurrences to preview
+func (r *PreviewOccurrencesRequest) PreviewLimit() int {
+	if r.Limit == 0 {
+		return DefaultPreviewOccurrences
+	}
+	return r.Limit
+}
+
+// StartSeries makes a recurring task the first occurrence of its own series, unless it
+// is in one already
+func (t *Task) StartSeries() {
+	if t.Recurrence != "" && t.SeriesID == "" {
+		t.SeriesID, t.Occurrence = t.ID, 1
+	}
+}
+
+// UpcomingOccurrences returns the due dates of up to n occurrences after t in its
+// series. The series continues from t's due date, or from now when it has none, so
+// moving an occurrence's deadline moves the ones after it too.
+func (t *Task) UpcomingOccurrences(now time.Time, n int) ([]time.Time, error) {
+	if t.Recurrence == "" {
+		return nil, nil
+	}
+	rule, err := recurrence.Parse(t.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	start := now
+	if t.DueAt != nil {
+		start = *t.DueAt
+	}
+	if rule.Count != 0 {
+		// COUNT includes the occurrences before t
+		rule.Count -= max(t.Occurrence, 1) - 1
+		if rule.Count <= 1 {
+			return nil, nil
+		}
+	}
+	return rule.Occurrences(start, n+1)[1:], nil
+}
+
+// NextOccurrence returns the task with ID id that follows t in its series, or nil when
+// the series has ended. It copies t's details, due at the next occurrence and with the
+// reminder as long before it as t's was.
+func (t *Task) NextOccurrence(id string, now time.Time) (*Task, error) {
+	due, err := t.UpcomingOccurrences(now, 1)
+	if err != nil || len(due) == 0 {
+		return nil, err
+	}
+
+	next := &Task{
+		ID:          id,
+		Title:       t.Title,
+		Description: t.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Version:     1,
+		DueAt:       &due[0],
+		Priority:    t.Priority,
+		ProjectID:   t.ProjectID,
+		ParentID:    t.ParentID,
+		Recurrence:  t.Recurrence,
+		SeriesID:    t.SeriesID,
+		Occurrence:  max(t.Occurrence, 1) + 1,
+	}
+	if next.SeriesID == "" {
+		next.SeriesID = t.ID
+	}
+	if t.DueAt != nil && t.RemindAt != nil {
+		remindAt := due[0].Add(t.RemindAt.Sub(*t.DueAt))
+		next.RemindAt = &remindAt
+	}
+	return next, nil
+}
+
+// CompletesOccurrence reports whether an update from before to after completes a
+// recurring task, which creates its next occurrence
+func CompletesOccurrence(before, after *Task) bool {
+	return !before.Completed && after.Completed && after.Recurrence != ""
+}
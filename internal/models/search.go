@@ -0,0 +1,62 @@
+package models
+
+import "strings"
+
+// SearchTasksRequest represents the internal request for full-text search
+type SearchTasksRequest struct {
+	Query     string        `json:"query"`
+	PageSize  int32         `json:"page_size"`
+	PageToken string        `json:"page_token"`
+	Cursor    *SearchCursor `json:"-"` // decoded from PageToken; nil for the first page
+}
+
+// Validate validates the search request
+func (r *SearchTasksRequest) Validate() error {
+	var v ValidationError
+	if strings.TrimSpace(r.Query) == "" {
+		v.Add("query", ReasonRequired, "query cannot be empty")
+	} else if len(r.Query) > 500 {
+		v.Add("query", ReasonTooLong, "query cannot exceed 500 characters")
+	}
+	return v.Err()
+}
+
+// SearchCursor identifies the last result of a page in (rank, id) descending order
+type SearchCursor struct {
+	Rank float64 `json:"rank"`
+	ID   string  `json:"id"`
+}
+
+// SearchResult is a matching task with its relevance and highlighted fragments
+type SearchResult struct {
+	Task               *Task   `json:"task"`
+	Rank               float64 `json:"rank"`
+	TitleSnippet       string  `json:"title_snippet"`
+	DescriptionSnippet string  `json:"description_snippet"`
+}
+
+// Before reports whether r is listed before the cursor position
+func (r *SearchResult) Before(cursor *SearchCursor) bool {
+	if r.Rank != cursor.Rank {
+		return r.Rank > cursor.Rank
+	}
+	return r.Task.ID > cursor.ID
+}
+
+// SearchTasksResponse represents the internal response for full-text search
+type SearchTasksResponse struct {
+	Results       []*SearchResult `json:"results"`
+	NextPageToken string          `json:"next_page_token"`
+	NextCursor    *SearchCursor   `json:"-"` // nil when there are no more pages
+}
+
+// NewSearchTasksResponse builds a page from up to pageSize+1 ranked results
+func NewSearchTasksResponse(results []*SearchResult, pageSize int32) *SearchTasksResponse {
+	resp := &SearchTasksResponse{Results: results}
+	if len(results) > int(pageSize) {
+		resp.Results = results[:pageSize]
+		last := resp.Results[len(resp.Results)-1]
+		resp.NextCursor = &SearchCursor{Rank: last.Rank, ID: last.Task.ID}
+	}
+	return resp
+}
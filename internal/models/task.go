@@ -1,87 +1,209 @@
 package models
 
 import (
-	"errors"
+	"fmt"
 	"time"
 )
 
+// Task fields that can be named in an update mask
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldCompleted   = "completed"
+	FieldDueAt       = "due_at"
+	FieldRemindAt    = "remind_at"
+	FieldPriority    = "priority"
+	FieldParentID    = "parent_id"
+	FieldRecurrence  = "recurrence"
+)
+
+// FieldPosition is the task's ranking key; only MoveTask changes it
+const FieldPosition = "position"
+
+// UpdatableFields lists every field an update may change, in column order
+var UpdatableFields = []string{FieldTitle, FieldDescription, FieldCompleted, FieldDueAt, FieldRemindAt, FieldPriority,
+	FieldParentID, FieldRecurrence}
+
+// DefaultUpdateFields is what an update with an empty mask changes: the fields that
+// predate update masks, so older clients never clear deadlines they do not know about
+var DefaultUpdateFields = []string{FieldTitle, FieldDescription, FieldCompleted}
+
+// Priority is how urgent a task is; the zero value means no priority was set
+type Priority int32
+
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
+// priorityNames are the names used for priorities in task history
+var priorityNames = []string{"none", "low", "medium", "high", "urgent"}
+
+// Valid reports whether p is one of the defined priorities
+func (p Priority) Valid() bool {
+	return p >= PriorityNone && p <= PriorityUrgent
+}
+
+func (p Priority) String() string {
+	if !p.Valid() {
+		return fmt.Sprintf("Priority(%d)", int32(p))
+	}
+	return priorityNames[p]
+}
+
 // Task represents the internal domain model for a task
 type Task struct {
-	ID          string    `json:"id" db:"id"`
-	Title       string    `json:"title" db:"title"`
-	Description string    `json:"description" db:"description"`
-	Completed   bool      `json:"completed" db:"completed"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID          string     `json:"id" db:"id"`
+	Title       string     `json:"title" db:"title"`
+	Description string     `json:"description" db:"description"`
+	Completed   bool       `json:"completed" db:"completed"` // whether Status is a done status of the workflow
+	Status      string     `json:"status" db:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" db:"completed_at"` // when the task last entered a done status
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+	Version     int64      `json:"version" db:"version"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty" db:"deleted_at"` // set while in the trash
+	DueAt       *time.Time `json:"due_at,omitempty" db:"due_at"`
+	RemindAt    *time.Time `json:"remind_at,omitempty" db:"remind_at"`
+	RemindedAt  *time.Time `json:"reminded_at,omitempty" db:"reminded_at"` // set once the reminder fired
+	Priority    Priority   `json:"priority" db:"priority"`
+	Position    string     `json:"position" db:"position"`     // rank key for manual ordering, see package rank
+	Labels      LabelList  `json:"labels" db:"labels"`         // ordered by name
+	ProjectID   string     `json:"project_id" db:"project_id"` // the Project the task belongs to
+	ParentID    string     `json:"parent_id" db:"parent_id"`   // the task this is a subtask of; empty at the top level
+	BlockedBy   IDList     `json:"blocked_by" db:"blocked_by"` // tasks to be completed before this one
+	Blocked     bool       `json:"blocked" db:"blocked"`       // whether a live task in BlockedBy is not completed
+	Recurrence  string     `json:"recurrence" db:"recurrence"` // RRULE for the next occurrence, see package recurrence
+	SeriesID    string     `json:"series_id" db:"series_id"`   // the first task of the series this is an occurrence of
+	Occurrence  int        `json:"occurrence" db:"occurrence"` // number of the occurrence in its series, from 1
+}
+
+// ReminderDue reports whether task's reminder should fire at now
+func (t *Task) ReminderDue(now time.Time) bool {
+	return t.RemindAt != nil && !t.RemindAt.After(now) && t.RemindedAt == nil &&
+		t.DeletedAt == nil && !t.Completed
 }
 
 // Validate validates the task fields
 func (t *Task) Validate() error {
-	if t.Title == "" {
-		return errors.New("title cannot be empty")
-	}
-	if len(t.Title) > 255 {
-		return errors.New("title cannot exceed 255 characters")
-	}
-	if len(t.Description) > 1000 {
-		return errors.New("description cannot exceed 1000 characters")
-	}
-	return nil
+	var v ValidationError
+	validateTitle(&v, t.Title)
+	validateDescription(&v, t.Description)
+	return v.Err()
 }
 
 // CreateTaskRequest represents the internal request for creating a task
 type CreateTaskRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	RemindAt    *time.Time `json:"remind_at,omitempty"`
+	Priority    Priority   `json:"priority"`
+	ProjectID   string     `json:"project_id"` // the parent's project, or DefaultProjectID, when empty
+	ParentID    string     `json:"parent_id"`  // makes the task a subtask
+	Recurrence  string     `json:"recurrence"` // RRULE that makes the task recur when completed
+	Status      string     `json:"status"`     // the workflow's initial status when empty
 }
 
 // Validate validates the create task request
 func (r *CreateTaskRequest) Validate() error {
-	if r.Title == "" {
-		return errors.New("title cannot be empty")
-	}
-	if len(r.Title) > 255 {
-		return errors.New("title cannot exceed 255 characters")
-	}
-	if len(r.Description) > 1000 {
-		return errors.New("description cannot exceed 1000 characters")
-	}
-	return nil
+	var v ValidationError
+	validateTitle(&v, r.Title)
+	validateDescription(&v, r.Description)
+	validatePriority(&v, r.Priority)
+	validateRecurrence(&v, r.Recurrence)
+	return v.Err()
 }
 
 // UpdateTaskRequest represents the internal request for updating a task
 type UpdateTaskRequest struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`           // moves the task between the open and done statuses, see Workflow.Settle
+	DueAt       *time.Time `json:"due_at,omitempty"`    // nil clears the deadline
+	RemindAt    *time.Time `json:"remind_at,omitempty"` // nil clears the reminder
+	Priority    Priority   `json:"priority"`
+	ParentID    string     `json:"parent_id"`   // empty moves the task to the top level
+	Recurrence  string     `json:"recurrence"`  // empty stops the task recurring
+	Version     int64      `json:"version"`     // expected current version; 0 skips the check
+	UpdateMask  []string   `json:"update_mask"` // fields to change; empty means DefaultUpdateFields, "*" all
+
+	IgnoreBlockers bool `json:"ignore_blockers"` // complete the task even while it is blocked
+}
+
+// Fields returns the fields this request changes
+func (r *UpdateTaskRequest) Fields() []string {
+	if len(r.UpdateMask) == 0 {
+		return DefaultUpdateFields
+	}
+	if len(r.UpdateMask) == 1 && r.UpdateMask[0] == "*" {
+		return UpdatableFields
+	}
+	return r.UpdateMask
 }
 
 // Validate validates the update task request
 func (r *UpdateTaskRequest) Validate() error {
+	var v ValidationError
 	if r.ID == "" {
-		return errors.New("id cannot be empty")
-	}
-	if r.Title == "" {
-		return errors.New("title cannot be empty")
-	}
-	if len(r.Title) > 255 {
-		return errors.New("title cannot exceed 255 characters")
-	}
-	if len(r.Description) > 1000 {
-		return errors.New("description cannot exceed 1000 characters")
+		v.Add("id", ReasonRequired, "id cannot be empty")
 	}
-	return nil
-}
 
-// ListTasksRequest represents the internal request for listing tasks
-type ListTasksRequest struct {
-	PageToken string `json:"page_token"`
-	PageSize  int32  `json:"page_size"`
+	seen := make(map[string]bool)
+	for _, field := range r.Fields() {
+		if seen[field] {
+			v.Add("update_mask", ReasonDuplicate, fmt.Sprintf("update_mask lists %q more than once", field))
+			continue
+		}
+		seen[field] = true
+
+		switch field {
+		case FieldTitle:
+			validateTitle(&v, r.Title)
+		case FieldDescription:
+			validateDescription(&v, r.Description)
+		case FieldPriority:
+			validatePriority(&v, r.Priority)
+		case FieldParentID:
+			if r.ParentID != "" && r.ParentID == r.ID {
+				v.Add(FieldParentID, ReasonInvalidFormat, "a task cannot be its own parent")
+			}
+		case FieldRecurrence:
+			validateRecurrence(&v, r.Recurrence)
+		case FieldCompleted, FieldDueAt, FieldRemindAt:
+		default:
+			v.Add("update_mask", ReasonUnknownField, fmt.Sprintf("update_mask contains unknown field %q", field))
+		}
+	}
+	return v.Err()
 }
 
-// ListTasksResponse represents the internal response for listing tasks
-type ListTasksResponse struct {
-	Tasks         []*Task `json:"tasks"`
-	NextPageToken string  `json:"next_page_token"`
+// ApplyTo copies the masked fields of the request onto task
+func (r *UpdateTaskRequest) ApplyTo(task *Task) {
+	for _, field := range r.Fields() {
+		switch field {
+		case FieldTitle:
+			task.Title = r.Title
+		case FieldDescription:
+			task.Description = r.Description
+		case FieldCompleted:
+			task.Completed = r.Completed
+		case FieldDueAt:
+			task.DueAt = r.DueAt
+		case FieldRemindAt:
+			// Setting the reminder again re-arms it
+			task.RemindAt, task.RemindedAt = r.RemindAt, nil
+		case FieldPriority:
+			task.Priority = r.Priority
+		case FieldParentID:
+			task.ParentID = r.ParentID
+		case FieldRecurrence:
+			task.Recurrence = r.Recurrence
+			task.StartSeries()
+		}
+	}
 }
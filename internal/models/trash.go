@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// TrashCursor identifies the last task of a trash page in (deleted_at, id) descending order
+type TrashCursor struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	ID        string    `json:"id"`
+}
+
+// ListDeletedTasksRequest represents the internal request for listing the trash
+type ListDeletedTasksRequest struct {
+	PageToken string       `json:"page_token"`
+	PageSize  int32        `json:"page_size"`
+	Cursor    *TrashCursor `json:"-"` // decoded from PageToken; nil for the first page
+}
+
+// ListDeletedTasksResponse represents the internal response for listing the trash
+type ListDeletedTasksResponse struct {
+	Tasks         []*Task      `json:"tasks"`
+	NextPageToken string       `json:"next_page_token"`
+	NextCursor    *TrashCursor `json:"-"` // nil when there are no more pages
+}
+
+// DeletedBefore reports whether task is listed after the cursor position
+func (c *TrashCursor) DeletedBefore(task *Task) bool {
+	if !task.DeletedAt.Equal(c.DeletedAt) {
+		return task.DeletedAt.Before(c.DeletedAt)
+	}
+	return task.ID < c.ID
+}
+
+// NewListDeletedTasksResponse builds a page from up to pageSize+1 trashed tasks
+func NewListDeletedTasksResponse(tasks []*Task, pageSize int32) *ListDeletedTasksResponse {
+	resp := &ListDeletedTasksResponse{Tasks: tasks}
+	if len(tasks) > int(pageSize) {
+		resp.Tasks = tasks[:pageSize]
+		last := resp.Tasks[len(resp.Tasks)-1]
+		resp.NextCursor = &TrashCursor{DeletedAt: *last.DeletedAt, ID: last.ID}
+	}
+	return resp
+}
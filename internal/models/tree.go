@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Bounds for GetTaskTreeRequest.Depth
+const (
+	DefaultTreeDepth = 3
+	MaxTreeDepth     = 10
+)
+
+// TaskNode is a task in a task tree with the subtasks returned below it
+type TaskNode struct {
+	Task              *Task
+	Subtasks          []*TaskNode // by position; empty below the requested depth
+	SubtaskCount      int         // live subtasks at every level below the task
+	CompletedSubtasks int         // how many of SubtaskCount are completed
+}
+
+// Progress is the percentage of the node's subtasks that are completed; a task without
+// subtasks is either done or not
+func (n *TaskNode) Progress() int {
+	if n.SubtaskCount == 0 {
+		if n.Task.Completed {
+			return 100
+		}
+		return 0
+	}
+	return n.CompletedSubtasks * 100 / n.SubtaskCount
+}
+
+// NewTaskTree links nodes into the tree under the node with ID root, ordering each
+// task's subtasks by position. Nodes whose parent is missing are dropped; it returns
+// nil if root is.
+func NewTaskTree(root string, nodes []*TaskNode) *TaskNode {
+	byID := make(map[string]*TaskNode, len(nodes))
+	for _, node := range nodes {
+		byID[node.Task.ID] = node
+	}
+	for _, node := range nodes {
+		if node.Task.ID == root {
+			continue
+		}
+		if parent, ok := byID[node.Task.ParentID]; ok {
+			parent.Subtasks = append(parent.Subtasks, node)
+		}
+	}
+	for _, node := range nodes {
+		sort.Slice(node.Subtasks, func(i, j int) bool {
+			a, b := node.Subtasks[i].Task, node.Subtasks[j].Task
+			if a.Position != b.Position {
+				return a.Position < b.Position
+			}
+			return a.ID < b.ID
+		})
+	}
+	return byID[root]
+}
+
+// GetTaskTreeRequest asks for a task with its subtasks nested Depth levels deep
+type GetTaskTreeRequest struct {
+	ID    string `json:"id"`
+	Depth int    `json:"depth"` // 0 means DefaultTreeDepth
+}
+
+// Validate validates the get task tree request
+func (r *GetTaskTreeRequest) Validate() error {
+	var v ValidationError
+	if r.ID == "" {
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	}
+	if r.Depth < 0 || r.Depth > MaxTreeDepth {
+		v.Add("depth", ReasonInvalidFormat, fmt.Sprintf("depth must be between 0 and %d", MaxTreeDepth))
+	}
+	return v.Err()
+}
+
+// TreeDepth returns the requested depth, applying the default
+func (r *GetTaskTreeRequest) TreeDepth() int {
+	if r.Depth == 0 {
+		return DefaultTreeDepth
+	}
+	return r.Depth
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/recurrence"
+)
+
+// Stable reasons for field violations, safe for clients to switch on
+const (
+	ReasonRequired      = "REQUIRED"
+	ReasonTooLong       = "TOO_LONG"
+	ReasonInvalidFormat = "INVALID_FORMAT"
+	ReasonUnknownField  = "UNKNOWN_FIELD"
+	ReasonDuplicate     = "DUPLICATE"
+)
+
+// FieldViolation describes one invalid field of a request
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Reason      string `json:"reason"`
+	Description string `json:"description"`
+}
+
+// ValidationError collects every violation found in a request
+type ValidationError struct {
+	Violations []FieldViolation `json:"violations"`
+}
+
+// Add records a violation
+func (e *ValidationError) Add(field, reason, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Reason: reason, Description: description})
+}
+
+// AddNested records the violations of err under prefix, e.g. "requests[3]"
+func (e *ValidationError) AddNested(prefix string, err error) {
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		e.Add(prefix, ReasonInvalidFormat, err.Error())
+		return
+	}
+	for _, v := range verr.Violations {
+		v.Field = prefix + "." + v.Field
+		v.Description = prefix + ": " + v.Description
+		e.Violations = append(e.Violations, v)
+	}
+}
+
+// Merge records the violations of err, the result of another check, so one response can
+// report every problem with a request. Errors other than ValidationError are recorded
+// against no particular field.
+func (e *ValidationError) Merge(err error) {
+	if err == nil {
+		return
+	}
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		e.Add("", ReasonInvalidFormat, err.Error())
+		return
+	}
+	e.Violations = append(e.Violations, verr.Violations...)
+}
+
+// Err returns e if any violation was recorded, otherwise nil
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Description
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// validateTitle checks a required task title
+func validateTitle(v *ValidationError, title string) {
+	if title == "" {
+		v.Add(FieldTitle, ReasonRequired, "title cannot be empty")
+	} else if len(title) > 255 {
+		v.Add(FieldTitle, ReasonTooLong, "title cannot exceed 255 characters")
+	}
+}
+
+// validatePriority checks that a priority is one of the defined values
+func validatePriority(v *ValidationError, p Priority) {
+	if !p.Valid() {
+		v.Add(FieldPriority, ReasonInvalidFormat, fmt.Sprintf("priority %d is not defined", int32(p)))
+	}
+}
+
+// validateDescription checks an optional task description
+func validateDescription(v *ValidationError, description string) {
+	if len(description) > 1000 {
+		v.Add(FieldDescription, ReasonTooLong, "description cannot exceed 1000 characters")
+	}
+}
+
+// validateRecurrence checks an optional recurrence rule
+func validateRecurrence(v *ValidationError, rule string) {
+	if rule == "" {
+		return
+	}
+	if len(rule) > 255 {
+		v.Add(FieldRecurrence, ReasonTooLong, "recurrence cannot exceed 255 characters")
+	} else if _, err := recurrence.Parse(rule); err != nil {
+		v.Add(FieldRecurrence, ReasonInvalidFormat, err.Error())
+	}
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Change types carried by TaskEvent
+const (
+	ChangeCreated  = "created"
+	ChangeUpdated  = "updated"
+	ChangeDeleted  = "deleted"
+	ChangeRestored = "restored"
+	ChangeReminded = "reminded" // the task's remind_at passed; the task itself is unchanged
+)
+
+// TaskEvent is one change to a task. Seq orders the change feed and doubles as the
+// cursor a watcher resumes from.
+type TaskEvent struct {
+	Seq        int64     `json:"seq"`
+	Type       string    `json:"type"`
+	Task       *Task     `json:"task"` // the task as it was right after the change
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// WatchTasksRequest represents the internal request for watching task changes
+type WatchTasksRequest struct {
+	After int64 `json:"after"` // resume after this seq; 0 starts with the next change
+}
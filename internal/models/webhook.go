@@ -0,0 +1,206 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// Event types a webhook can subscribe to. Each change is published as "task." plus its
+// Change* type; task.completed additionally marks updates that complete a task.
+const (
+	EventTaskCreated   = "task." + ChangeCreated
+	EventTaskUpdated   = "task." + ChangeUpdated
+	EventTaskCompleted = "task.completed"
+	EventTaskDeleted   = "task." + ChangeDeleted
+	EventTaskRestored  = "task." + ChangeRestored
+	EventTaskReminded  = "task." + ChangeReminded
+	EventTaskPurged    = "task." + ChangePurged
+)
+
+// WebhookEventTypes lists every event type accepted in a webhook filter
+var WebhookEventTypes = []string{
+	EventTaskCreated, EventTaskUpdated, EventTaskCompleted,
+	EventTaskDeleted, EventTaskRestored, EventTaskReminded, EventTaskPurged,
+}
+
+// Webhook limits
+const (
+	MaxWebhookURLLength    = 2048
+	MinWebhookSecretLength = 16
+	MaxWebhookSecretLength = 256
+)
+
+// EventTypes lists the event types a change from before to after is published under,
+// most specific first
+func EventTypes(kind string, before, after *Task) []string {
+	if kind == ChangeUpdated && before != nil && !before.Completed && after.Completed {
+		return []string{EventTaskCompleted, EventTaskUpdated}
+	}
+	return []string{"task." + kind}
+}
+
+// Webhook is an endpoint subscribed to task events
+type Webhook struct {
+	ID             string     `json:"id"`
+	URL            string     `json:"url"`
+	EventTypes     []string   `json:"event_types"` // empty subscribes to every event
+	Secret         string     `json:"-"`
+	CreatedAt      time.Time  `json:"created_at"`
+	FailureCount   int        `json:"failure_count"`           // consecutive failed attempts
+	FailingSince   *time.Time `json:"failing_since,omitempty"` // first of those attempts
+	DisabledAt     *time.Time `json:"disabled_at,omitempty"`
+	DisabledReason string     `json:"disabled_reason,omitempty"`
+}
+
+// Accepts returns the first of types, as listed by EventTypes, that w subscribes to
+func (w *Webhook) Accepts(types []string) (string, bool) {
+	for _, t := range types {
+		if len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, t) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// WebhookDisablePolicy decides when a failing webhook is disabled: once it has failed
+// at least Failures consecutive attempts spread over at least Period. A zero Failures
+// never disables.
+type WebhookDisablePolicy struct {
+	Failures int
+	Period   time.Duration
+}
+
+// RecordAttempt updates w's failure streak with attempt and disables w when policy says
+// so. It reports whether w was disabled by this attempt.
+func (w *Webhook) RecordAttempt(attempt *WebhookAttempt, policy WebhookDisablePolicy) bool {
+	if attempt.Succeeded() {
+		w.FailureCount, w.FailingSince = 0, nil
+		return false
+	}
+
+	w.FailureCount++
+	if w.FailingSince == nil {
+		since := attempt.AttemptedAt
+		w.FailingSince = &since
+	}
+	if w.DisabledAt != nil || policy.Failures <= 0 || w.FailureCount < policy.Failures ||
+		attempt.AttemptedAt.Sub(*w.FailingSince) < policy.Period {
+		return false
+	}
+
+	disabledAt := attempt.AttemptedAt
+	w.DisabledAt = &disabledAt
+	w.DisabledReason = fmt.Sprintf("%d consecutive failed deliveries since %s; last error: %s",
+		w.FailureCount, w.FailingSince.UTC().Format(time.RFC3339), attempt.Error)
+	return true
+}
+
+// CreateWebhookRequest represents the internal request for registering a webhook
+type CreateWebhookRequest struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"-"` // generated when empty
+}
+
+// Validate validates the create webhook request
+func (r *CreateWebhookRequest) Validate() error {
+	var v ValidationError
+
+	switch u, err := url.Parse(r.URL); {
+	case r.URL == "":
+		v.Add("url", ReasonRequired, "url cannot be empty")
+	case len(r.URL) > MaxWebhookURLLength:
+		v.Add("url", ReasonTooLong, fmt.Sprintf("url cannot exceed %d characters", MaxWebhookURLLength))
+	case err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "":
+		v.Add("url", ReasonInvalidFormat, "url must be an absolute http or https URL")
+	}
+
+	for i, t := range r.EventTypes {
+		field := fmt.Sprintf("event_types[%d]", i)
+		switch {
+		case !slices.Contains(WebhookEventTypes, t):
+			v.Add(field, ReasonInvalidFormat, fmt.Sprintf("unknown event type %q", t))
+		case slices.Contains(r.EventTypes[:i], t):
+			v.Add(field, ReasonDuplicate, fmt.Sprintf("event type %q is listed more than once", t))
+		}
+	}
+
+	if r.Secret != "" && (len(r.Secret) < MinWebhookSecretLength || len(r.Secret) > MaxWebhookSecretLength) {
+		v.Add("secret", ReasonInvalidFormat, fmt.Sprintf("secret must be between %d and %d characters",
+			MinWebhookSecretLength, MaxWebhookSecretLength))
+	}
+	return v.Err()
+}
+
+// WebhookDelivery is one event queued for one webhook until it is delivered or given up on
+type WebhookDelivery struct {
+	ID        int64           `json:"id"`
+	Webhook   *Webhook        `json:"-"` // the endpoint and secret to deliver to
+	EventType string          `json:"event_type"`
+	TaskID    string          `json:"task_id"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	Attempts  int             `json:"attempts"` // failed attempts so far
+}
+
+// WebhookAttempt is one try at a delivery, as kept in the delivery log
+type WebhookAttempt struct {
+	ID            int64         `json:"id"`
+	DeliveryID    int64         `json:"delivery_id"`
+	WebhookID     string        `json:"webhook_id"`
+	EventType     string        `json:"event_type"`
+	TaskID        string        `json:"task_id"`
+	Attempt       int           `json:"attempt"`     // 1 for the first attempt
+	StatusCode    int           `json:"status_code"` // 0 if no response was received
+	Error         string        `json:"error"`       // empty when the endpoint accepted the event
+	Duration      time.Duration `json:"duration"`
+	AttemptedAt   time.Time     `json:"attempted_at"`
+	NextAttemptAt *time.Time    `json:"next_attempt_at,omitempty"` // nil after success or the final attempt
+}
+
+// Succeeded reports whether the endpoint accepted the event
+func (a *WebhookAttempt) Succeeded() bool {
+	return a.Error == ""
+}
+
+// DeliveryCursor identifies the last attempt of a delivery log page in id descending order
+type DeliveryCursor struct {
+	ID int64 `json:"id"`
+}
+
+// ListWebhookDeliveriesRequest represents the internal request for a webhook's delivery log
+type ListWebhookDeliveriesRequest struct {
+	WebhookID string          `json:"webhook_id"`
+	PageToken string          `json:"page_token"`
+	PageSize  int32           `json:"page_size"`
+	Cursor    *DeliveryCursor `json:"-"` // decoded from PageToken; nil for the first page
+}
+
+// Validate validates the list webhook deliveries request
+func (r *ListWebhookDeliveriesRequest) Validate() error {
+	var v ValidationError
+	if r.WebhookID == "" {
+		v.Add("webhook_id", ReasonRequired, "webhook_id cannot be empty")
+	}
+	return v.Err()
+}
+
+// ListWebhookDeliveriesResponse represents the internal response for a webhook's delivery log
+type ListWebhookDeliveriesResponse struct {
+	Attempts      []*WebhookAttempt `json:"attempts"`
+	NextPageToken string            `json:"next_page_token"`
+	NextCursor    *DeliveryCursor   `json:"-"` // nil when there are no more pages
+}
+
+// NewListWebhookDeliveriesResponse builds a page from up to pageSize+1 attempts, newest first
+func NewListWebhookDeliveriesResponse(attempts []*WebhookAttempt, pageSize int32) *ListWebhookDeliveriesResponse {
+	resp := &ListWebhookDeliveriesResponse{Attempts: attempts}
+	if len(attempts) > int(pageSize) {
+		resp.Attempts = attempts[:pageSize]
+		resp.NextCursor = &DeliveryCursor{ID: resp.Attempts[len(resp.Attempts)-1].ID}
+	}
+	return resp
+}
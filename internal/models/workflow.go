@@ -0,0 +1,188 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// FieldStatus is the task's place in the workflow; only TransitionTask changes it
+const FieldStatus = "status"
+
+// anyStatus stands for every status on either side of a transition
+const anyStatus = "*"
+
+// statusName is the form of status names
+var statusName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Workflow is the state machine of task statuses. Tasks in a done status count as
+// completed; the rest are open.
+type Workflow struct {
+	statuses    []string
+	done        map[string]bool
+	transitions map[string]map[string]bool // to statuses by from status, either may be anyStatus
+}
+
+// NewWorkflow builds a workflow from its statuses in board order, the done ones among
+// them and the allowed transitions, each "from>to" with "*" for any status. New tasks
+// start in the first status, which must be open; clients that only know Completed
+// complete tasks into the first done status and reopen them into the first status.
+func NewWorkflow(statuses, done, transitions []string) (*Workflow, error) {
+	w := &Workflow{
+		statuses:    statuses,
+		done:        make(map[string]bool),
+		transitions: make(map[string]map[string]bool),
+	}
+
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("workflow needs at least one status")
+	}
+	for i, status := range statuses {
+		if !statusName.MatchString(status) {
+			return nil, fmt.Errorf("status %q must be lowercase letters, digits and underscores", status)
+		}
+		if slices.Contains(statuses[:i], status) {
+			return nil, fmt.Errorf("status %q is listed more than once", status)
+		}
+	}
+
+	if len(done) == 0 {
+		return nil, fmt.Errorf("workflow needs at least one done status")
+	}
+	for _, status := range done {
+		if !slices.Contains(statuses, status) {
+			return nil, fmt.Errorf("done status %q is not a status", status)
+		}
+		w.done[status] = true
+	}
+	if w.done[statuses[0]] {
+		return nil, fmt.Errorf("initial status %q cannot be a done status", statuses[0])
+	}
+
+	for _, transition := range transitions {
+		from, to, ok := strings.Cut(transition, ">")
+		if !ok {
+			return nil, fmt.Errorf("transition %q must be written from>to", transition)
+		}
+		for _, status := range []string{from, to} {
+			if status != anyStatus && !slices.Contains(statuses, status) {
+				return nil, fmt.Errorf("transition %q names unknown status %q", transition, status)
+			}
+		}
+		if w.transitions[from] == nil {
+			w.transitions[from] = make(map[string]bool)
+		}
+		w.transitions[from][to] = true
+	}
+	return w, nil
+}
+
+// Statuses returns the statuses in board order
+func (w *Workflow) Statuses() []string {
+	return slices.Clone(w.statuses)
+}
+
+// Initial returns the status new tasks start in
+func (w *Workflow) Initial() string {
+	return w.statuses[0]
+}
+
+// IsDone reports whether status completes a task
+func (w *Workflow) IsDone(status string) bool {
+	return w.done[status]
+}
+
+// Allows reports whether a task may move from one status to another. Tasks left in a
+// status the workflow no longer has may move to any status.
+func (w *Workflow) Allows(from, to string) bool {
+	if from == to || !slices.Contains(w.statuses, to) {
+		return false
+	}
+	if !slices.Contains(w.statuses, from) {
+		return true
+	}
+	return w.transitions[from][to] || w.transitions[from][anyStatus] ||
+		w.transitions[anyStatus][to] || w.transitions[anyStatus][anyStatus]
+}
+
+// Targets returns the statuses a task in status may move to, in board order
+func (w *Workflow) Targets(status string) []string {
+	var targets []string
+	for _, to := range w.statuses {
+		if w.Allows(status, to) {
+			targets = append(targets, to)
+		}
+	}
+	return targets
+}
+
+// CheckStatus returns a violation of field if status is set but not in the workflow
+func (w *Workflow) CheckStatus(field, status string) error {
+	var v ValidationError
+	if status != "" && !slices.Contains(w.statuses, status) {
+		v.Add(field, ReasonInvalidFormat, fmt.Sprintf("%s must be one of %s", field, strings.Join(w.statuses, ", ")))
+	}
+	return v.Err()
+}
+
+// Settle brings the completion fields of after in line with its status, for a change
+// from before (nil for a new task). New tasks without a status start in the initial
+// one. An update that only flips Completed, from clients that predate statuses, moves
+// the task to the first done status or back to the initial one. CompletedAt is set
+// when the task enters a done status and cleared when it leaves them.
+func (w *Workflow) Settle(before, after *Task) {
+	switch {
+	case before == nil:
+		if after.Status == "" {
+			after.Status = w.Initial()
+		}
+	case after.Status != before.Status:
+	case after.Completed != before.Completed:
+		if after.Completed {
+			after.Status = w.FirstDone()
+		} else {
+			after.Status = w.Initial()
+		}
+	default:
+		return
+	}
+
+	after.Completed = w.IsDone(after.Status)
+	if !after.Completed {
+		after.CompletedAt = nil
+	} else if before == nil || !before.Completed || after.CompletedAt == nil {
+		completedAt := after.UpdatedAt
+		after.CompletedAt = &completedAt
+	}
+}
+
+// FirstDone returns the first done status in board order
+func (w *Workflow) FirstDone() string {
+	for _, status := range w.statuses {
+		if w.done[status] {
+			return status
+		}
+	}
+	return ""
+}
+
+// TransitionTaskRequest moves a task to another status of the workflow
+type TransitionTaskRequest struct {
+	ID             string `json:"id"`
+	Status         string `json:"status"`
+	Version        int64  `json:"version"`         // expected current version; 0 skips the check
+	IgnoreBlockers bool   `json:"ignore_blockers"` // complete the task even while it is blocked
+}
+
+// Validate validates the transition task request
+func (r *TransitionTaskRequest) Validate() error {
+	var v ValidationError
+	if r.ID == "" {
+		v.Add("id", ReasonRequired, "id cannot be empty")
+	}
+	if r.Status == "" {
+		v.Add(FieldStatus, ReasonRequired, "status cannot be empty")
+	}
+	return v.Err()
+}
@@ -0,0 +1,236 @@
+package models
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// testWorkflow returns the default workflow from the server config
+func testWorkflow(t *testing.T) *Workflow {
+	t.Helper()
+	workflow, err := NewWorkflow(
+		[]string{"todo", "in_progress", "in_review", "done", "wont_do"},
+		[]string{"done", "wont_do"},
+		[]string{"todo>in_progress", "in_progress>todo", "in_progress>in_review", "in_review>in_progress",
+			"*>done", "*>wont_do", "done>todo", "wont_do>todo"})
+	if err != nil {
+		t.Fatalf("NewWorkflow: %v", err)
+	}
+	return workflow
+}
+
+func TestNewWorkflow(t *testing.T) {
+	workflow := testWorkflow(t)
+	if got := workflow.Initial(); got != "todo" {
+		t.Errorf("Initial() = %q, want todo", got)
+	}
+	if got := workflow.FirstDone(); got != "done" {
+		t.Errorf("FirstDone() = %q, want done", got)
+	}
+	if !workflow.IsDone("wont_do") || workflow.IsDone("in_review") || workflow.IsDone("unknown") {
+		t.Error("IsDone does not match the done statuses")
+	}
+
+	statuses := workflow.Statuses()
+	statuses[0] = "changed"
+	if workflow.Initial() != "todo" {
+		t.Error("changing the result of Statuses changed the workflow")
+	}
+}
+
+func TestNewWorkflowErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    []string
+		done        []string
+		transitions []string
+	}{
+		{"no statuses", nil, []string{"done"}, nil},
+		{"uppercase status", []string{"Todo", "done"}, []string{"done"}, nil},
+		{"status with a space", []string{"to do", "done"}, []string{"done"}, nil},
+		{"duplicate status", []string{"todo", "done", "todo"}, []string{"done"}, nil},
+		{"no done status", []string{"todo", "done"}, nil, nil},
+		{"unknown done status", []string{"todo", "done"}, []string{"shipped"}, nil},
+		{"initial status done", []string{"done", "todo"}, []string{"done"}, nil},
+		{"transition without arrow", []string{"todo", "done"}, []string{"done"}, []string{"todo-done"}},
+		{"transition from unknown status", []string{"todo", "done"}, []string{"done"}, []string{"doing>done"}},
+		{"transition to unknown status", []string{"todo", "done"}, []string{"done"}, []string{"todo>doing"}},
+	}
+	for _, tt := range tests {
+		if _, err := NewWorkflow(tt.statuses, tt.done, tt.transitions); err == nil {
+			t.Errorf("%s: NewWorkflow succeeded", tt.name)
+		}
+	}
+}
+
+func TestAllows(t *testing.T) {
+	workflow := testWorkflow(t)
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"todo", "in_progress", true},
+		{"in_progress", "todo", true},
+		{"todo", "in_review", false},
+		{"in_review", "todo", false},
+		{"todo", "done", true}, // *>done
+		{"in_review", "wont_do", true},
+		{"done", "todo", true},
+		{"done", "in_progress", false},
+		{"done", "wont_do", true},
+		{"todo", "todo", false},
+		{"done", "done", false},
+		{"todo", "archived", false},
+		{"archived", "in_review", true}, // left over from an earlier workflow
+	}
+	for _, tt := range tests {
+		if got := workflow.Allows(tt.from, tt.to); got != tt.want {
+			t.Errorf("Allows(%q, %q) = %t, want %t", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	open, err := NewWorkflow([]string{"new", "old"}, []string{"old"}, []string{"*>*"})
+	if err != nil {
+		t.Fatalf("NewWorkflow: %v", err)
+	}
+	if !open.Allows("new", "old") || !open.Allows("old", "new") || open.Allows("new", "new") {
+		t.Error("*>* does not allow every move between different statuses")
+	}
+
+	if got, want := workflow.Targets("todo"), []string{"in_progress", "done", "wont_do"}; !slices.Equal(got, want) {
+		t.Errorf("Targets(todo) = %v, want %v", got, want)
+	}
+	if got := workflow.Targets("in_review"); !slices.Equal(got, []string{"in_progress", "done", "wont_do"}) {
+		t.Errorf("Targets(in_review) = %v", got)
+	}
+}
+
+func TestCheckStatus(t *testing.T) {
+	workflow := testWorkflow(t)
+	if err := workflow.CheckStatus(FieldStatus, ""); err != nil {
+		t.Errorf("CheckStatus of no status: %v", err)
+	}
+	if err := workflow.CheckStatus(FieldStatus, "in_review"); err != nil {
+		t.Errorf("CheckStatus(in_review): %v", err)
+	}
+	if err := workflow.CheckStatus(FieldStatus, "archived"); err == nil {
+		t.Error("CheckStatus(archived) succeeded")
+	}
+}
+
+func TestSettle(t *testing.T) {
+	earlier := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Hour)
+
+	shipping, err := NewWorkflow([]string{"backlog", "building", "shipped", "dropped"}, []string{"shipped", "dropped"},
+		[]string{"*>*"})
+	if err != nil {
+		t.Fatalf("NewWorkflow: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		workflow        *Workflow
+		before          *Task // nil for a new task
+		after           Task
+		wantStatus      string
+		wantCompleted   bool
+		wantCompletedAt *time.Time
+	}{
+		{
+			name:       "new task starts in the initial status",
+			workflow:   testWorkflow(t),
+			after:      Task{UpdatedAt: now},
+			wantStatus: "todo",
+		},
+		{
+			name:       "new task in a custom workflow",
+			workflow:   shipping,
+			after:      Task{UpdatedAt: now, Completed: true},
+			wantStatus: "backlog",
+		},
+		{
+			name:            "new task created done",
+			workflow:        testWorkflow(t),
+			after:           Task{UpdatedAt: now, Status: "done"},
+			wantStatus:      "done",
+			wantCompleted:   true,
+			wantCompletedAt: &now,
+		},
+		{
+			name:            "legacy complete moves to the first done status",
+			workflow:        testWorkflow(t),
+			before:          &Task{Status: "in_review", UpdatedAt: earlier},
+			after:           Task{Status: "in_review", Completed: true, UpdatedAt: now},
+			wantStatus:      "done",
+			wantCompleted:   true,
+			wantCompletedAt: &now,
+		},
+		{
+			name:            "legacy complete in a custom workflow",
+			workflow:        shipping,
+			before:          &Task{Status: "building", UpdatedAt: earlier},
+			after:           Task{Status: "building", Completed: true, UpdatedAt: now},
+			wantStatus:      "shipped",
+			wantCompleted:   true,
+			wantCompletedAt: &now,
+		},
+		{
+			name:       "legacy reopen moves to the initial status",
+			workflow:   testWorkflow(t),
+			before:     &Task{Status: "wont_do", Completed: true, CompletedAt: &earlier, UpdatedAt: earlier},
+			after:      Task{Status: "wont_do", CompletedAt: &earlier, UpdatedAt: now},
+			wantStatus: "todo",
+		},
+		{
+			name:            "status wins over completed",
+			workflow:        testWorkflow(t),
+			before:          &Task{Status: "todo", UpdatedAt: earlier},
+			after:           Task{Status: "wont_do", UpdatedAt: now},
+			wantStatus:      "wont_do",
+			wantCompleted:   true,
+			wantCompletedAt: &now,
+		},
+		{
+			name:            "moving between done statuses keeps the completion time",
+			workflow:        testWorkflow(t),
+			before:          &Task{Status: "done", Completed: true, CompletedAt: &earlier, UpdatedAt: earlier},
+			after:           Task{Status: "wont_do", Completed: true, CompletedAt: &earlier, UpdatedAt: now},
+			wantStatus:      "wont_do",
+			wantCompleted:   true,
+			wantCompletedAt: &earlier,
+		},
+		{
+			name:       "leaving the done statuses clears the completion time",
+			workflow:   testWorkflow(t),
+			before:     &Task{Status: "done", Completed: true, CompletedAt: &earlier, UpdatedAt: earlier},
+			after:      Task{Status: "in_progress", Completed: true, CompletedAt: &earlier, UpdatedAt: now},
+			wantStatus: "in_progress",
+		},
+		{
+			name:            "other changes leave completion alone",
+			workflow:        testWorkflow(t),
+			before:          &Task{Status: "done", Completed: true, CompletedAt: &earlier, UpdatedAt: earlier},
+			after:           Task{Title: "renamed", Status: "done", Completed: true, CompletedAt: &earlier, UpdatedAt: now},
+			wantStatus:      "done",
+			wantCompleted:   true,
+			wantCompletedAt: &earlier,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := tt.after
+			tt.workflow.Settle(tt.before, &after)
+			if after.Status != tt.wantStatus || after.Completed != tt.wantCompleted {
+				t.Errorf("status, completed = %q, %t; want %q, %t", after.Status, after.Completed, tt.wantStatus, tt.wantCompleted)
+			}
+			switch {
+			case tt.wantCompletedAt == nil && after.CompletedAt != nil:
+				t.Errorf("CompletedAt = %v, want nil", *after.CompletedAt)
+			case tt.wantCompletedAt != nil && (after.CompletedAt == nil || !after.CompletedAt.Equal(*tt.wantCompletedAt)):
+				t.Errorf("CompletedAt = %v, want %v", after.CompletedAt, *tt.wantCompletedAt)
+			}
+		})
+	}
+}
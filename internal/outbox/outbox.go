@@ -0,0 +1,109 @@
+// Package outbox relays task changes recorded in the outbox table to external sinks.
+//
+// Every TaskRepository write adds its outbox message in the same transaction, so a
+// change is published if and only if it commits. The relay delivers at least once:
+// a message is removed only after its sink accepts it, and consumers should
+// deduplicate on Message.ID and order a task's messages by the payload's version.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+)
+
+// Message is one outbox row as handed to a sink
+type Message struct {
+	ID        int64           `json:"id"`    // unique and increasing; use it to deduplicate
+	Topic     string          `json:"topic"` // e.g. "task.updated"
+	Key       string          `json:"key"`   // task ID; messages with the same key are sent in order
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	Attempt   int             `json:"attempt"` // 1 on first delivery
+}
+
+// Sink delivers messages to an external system. Send must return an error unless the
+// message was durably accepted; it may be called again with the same message.
+type Sink interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Source is an outbox the relay can drain
+type Source interface {
+	// DrainOutbox claims up to limit due messages not claimed by another relay, passes
+	// them to deliver in ID order and records each outcome using policy. It returns how
+	// many messages were claimed.
+	DrainOutbox(ctx context.Context, limit int, policy RetryPolicy, deliver func(context.Context, Message) error) (int, error)
+}
+
+// RetryPolicy decides when failed deliveries are retried and when they are dead-lettered
+type RetryPolicy struct {
+	MaxAttempts int           // attempts before a message is dead-lettered
+	BaseDelay   time.Duration // delay after the first failure, doubled on each retry
+	MaxDelay    time.Duration // upper bound on the delay between attempts; 0 is unbounded
+}
+
+// DefaultRetryPolicy retries for roughly an hour before dead-lettering
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 12, BaseDelay: time.Second, MaxDelay: 15 * time.Minute}
+
+// Exhausted reports whether a message that has failed attempts times should be dead-lettered
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
+
+// Delay returns how long to wait before the next attempt after attempts failures
+func (p RetryPolicy) Delay(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// Relay periodically drains a Source into a Sink
+type Relay struct {
+	source    Source
+	sink      Sink
+	policy    RetryPolicy
+	batchSize int
+	interval  time.Duration
+}
+
+// NewRelay creates a relay that polls source every interval, delivering up to
+// batchSize messages per transaction
+func NewRelay(source Source, sink Sink, policy RetryPolicy, batchSize int, interval time.Duration) *Relay {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return &Relay{source: source, sink: sink, policy: policy, batchSize: batchSize, interval: interval}
+}
+
+// Run drains the outbox until ctx is cancelled. A full batch is followed immediately by
+// the next one so a backlog clears without waiting for the poll interval.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.source.DrainOutbox(ctx, r.batchSize, r.policy, r.sink.Send)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to drain outbox: %v", err)
+		}
+		if err == nil && n == r.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	for attempts, want := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second,
+		9: 10 * time.Second,
+	} {
+		if got := policy.Delay(attempts); got != want {
+			t.Errorf("Delay(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+	if got := (RetryPolicy{BaseDelay: time.Second}).Delay(11); got != 1024*time.Second {
+		t.Errorf("unbounded Delay(11) = %v, want %v", got, 1024*time.Second)
+	}
+
+	if policy.Exhausted(4) || !policy.Exhausted(5) || !policy.Exhausted(6) {
+		t.Error("Exhausted does not give up at MaxAttempts")
+	}
+	if (RetryPolicy{}).Exhausted(1000) {
+		t.Error("a policy without MaxAttempts gave up")
+	}
+}
+
+// fakeSource is an in-memory outbox that keeps a message until deliver accepts it
+type fakeSource struct {
+	mu       sync.Mutex
+	messages []Message
+	policies []RetryPolicy
+}
+
+func (s *fakeSource) DrainOutbox(ctx context.Context, limit int, policy RetryPolicy, deliver func(context.Context, Message) error) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policies = append(s.policies, policy)
+
+	claimed := s.messages[:min(limit, len(s.messages))]
+	var kept []Message
+	for i, msg := range claimed {
+		msg.Attempt++
+		if err := deliver(ctx, msg); err != nil {
+			// Hold back the rest of the batch so delivery stays in order
+			kept = append(kept, msg)
+			kept = append(kept, claimed[i+1:]...)
+			break
+		}
+	}
+	s.messages = append(kept, s.messages[len(claimed):]...)
+	return len(claimed), nil
+}
+
+func (s *fakeSource) remaining() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.messages)
+}
+
+// fakeSink records accepted messages and fails the first attempts listed in failures
+type fakeSink struct {
+	mu       sync.Mutex
+	accepted []Message
+	attempts []int64
+	failures map[int64]int
+}
+
+func (s *fakeSink) Send(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts = append(s.attempts, msg.ID)
+	if s.failures[msg.ID] > 0 {
+		s.failures[msg.ID]--
+		return errors.New("sink unavailable")
+	}
+	s.accepted = append(s.accepted, msg)
+	return nil
+}
+
+func TestRelayRun(t *testing.T) {
+	source := &fakeSource{}
+	for id := int64(1); id <= 7; id++ {
+		source.messages = append(source.messages, Message{ID: id, Topic: "task.created", Key: "task"})
+	}
+	sink := &fakeSink{failures: map[int64]int{3: 2}}
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewRelay(source, sink, policy, 2, 5*time.Millisecond).Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for source.remaining() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d messages still in the outbox", source.remaining())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+
+	// Every message is accepted once, in order, and removed only after that
+	if len(sink.accepted) != 7 {
+		t.Fatalf("sink accepted %d messages, want 7", len(sink.accepted))
+	}
+	for i, msg := range sink.accepted {
+		if msg.ID != int64(i+1) {
+			t.Errorf("message %d accepted in position %d", msg.ID, i)
+		}
+		want := 1
+		if msg.ID == 3 {
+			want = 3
+		}
+		if msg.Attempt != want {
+			t.Errorf("message %d accepted on attempt %d, want %d", msg.ID, msg.Attempt, want)
+		}
+	}
+	sent := 0
+	for _, id := range sink.attempts {
+		if id == 3 {
+			sent++
+		}
+	}
+	if sent != 3 {
+		t.Errorf("message 3 was sent %d times, want 3", sent)
+	}
+	for _, p := range source.policies {
+		if p != policy {
+			t.Fatalf("DrainOutbox got policy %+v, want %+v", p, policy)
+		}
+	}
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+	for id := int64(1); id <= 2; id++ {
+		msg := Message{ID: id, Topic: "task.created", Key: "task", Payload: json.RawMessage(`{"version":1}`), Attempt: 1}
+		if err := sink.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("wrote %d lines, want 2: %q", len(lines), buf.String())
+	}
+	for i, line := range lines {
+		var msg Message
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			t.Fatalf("line %d is not a message: %v", i, err)
+		}
+		if msg.ID != int64(i+1) || msg.Topic != "task.created" || string(msg.Payload) != `{"version":1}` {
+			t.Errorf("line %d = %+v", i, msg)
+		}
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var status int
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		var msg Message
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("request body is not a message: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	sink := NewWebhookSink(srv.URL, time.Second)
+
+	status = http.StatusAccepted
+	if err := sink.Send(context.Background(), Message{ID: 41}); err != nil {
+		t.Errorf("Send with a 2xx response: %v", err)
+	}
+	status = http.StatusServiceUnavailable
+	if err := sink.Send(context.Background(), Message{ID: 42}); err == nil {
+		t.Error("Send with a 503 response succeeded")
+	}
+	if len(keys) != 2 || keys[0] != "41" || keys[1] != "42" {
+		t.Errorf("Idempotency-Key headers = %q, want the message IDs", keys)
+	}
+}
+
+func TestMultiSink(t *testing.T) {
+	ok, failing := &fakeSink{}, &fakeSink{failures: map[int64]int{1: 1}}
+	sink := MultiSink{failing, ok}
+
+	if err := sink.Send(context.Background(), Message{ID: 1}); err == nil {
+		t.Error("Send succeeded although one sink failed")
+	}
+	if len(ok.accepted) != 1 {
+		t.Error("a failing sink stopped delivery to the others")
+	}
+	if err := sink.Send(context.Background(), Message{ID: 1}); err != nil {
+		t.Errorf("retried Send: %v", err)
+	}
+	if len(ok.accepted) != 2 || len(failing.accepted) != 1 {
+		t.Errorf("after the retry the sinks accepted %d and %d messages, want 2 and 1",
+			len(ok.accepted), len(failing.accepted))
+	}
+}
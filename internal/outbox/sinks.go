@@ -0,0 +1,139 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// WriterSink writes each message as one line of JSON
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink creates a sink writing JSON lines to w, e.g. os.Stdout
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Send writes msg and, when the writer is a file, syncs it to disk
+func (s *WriterSink) Send(_ context.Context, msg Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message %d: %w", msg.ID, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write message %d: %w", msg.ID, err)
+	}
+	if f, ok := s.w.(*os.File); ok && f != os.Stdout && f != os.Stderr {
+		if err := f.Sync(); err != nil {
+			return fmt.Errorf("failed to sync message %d: %w", msg.ID, err)
+		}
+	}
+	return nil
+}
+
+// OpenFileSink appends JSON lines to the file at path, creating it if needed.
+// Close the returned file when the relay stops.
+func OpenFileSink(path string) (*WriterSink, *os.File, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open outbox file: %w", err)
+	}
+	return NewWriterSink(f), f, nil
+}
+
+// WebhookSink POSTs each message as JSON to a URL; any 2xx response accepts it
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a sink posting to url, giving up on a request after timeout
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// Send posts msg with an Idempotency-Key header set to its ID
+func (s *WebhookSink) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message %d: %w", msg.ID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", strconv.FormatInt(msg.ID, 10))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post message %d: %w", msg.ID, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook rejected message %d with status %s", msg.ID, resp.Status)
+	}
+	return nil
+}
+
+// Publisher is the subset of a NATS connection the NATS sink needs; *nats.Conn satisfies it
+type Publisher interface {
+	Publish(subject string, data []byte) error
+}
+
+// NATSSink publishes each message to subjectPrefix + its topic
+type NATSSink struct {
+	pub           Publisher
+	subjectPrefix string
+}
+
+// NewNATSSink creates a sink publishing through pub, e.g. with prefix "tasklist."
+func NewNATSSink(pub Publisher, subjectPrefix string) *NATSSink {
+	return &NATSSink{pub: pub, subjectPrefix: subjectPrefix}
+}
+
+// Send publishes msg as JSON. Plain NATS publishes are fire-and-forget; pass a
+// JetStream-backed Publisher when delivery must be acknowledged.
+func (s *NATSSink) Send(_ context.Context, msg Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message %d: %w", msg.ID, err)
+	}
+	if err := s.pub.Publish(s.subjectPrefix+msg.Topic, data); err != nil {
+		return fmt.Errorf("failed to publish message %d: %w", msg.ID, err)
+	}
+	return nil
+}
+
+// MultiSink sends every message to all of its sinks. A failure in any of them fails
+// the message, so sinks that already accepted it will see it again on retry.
+type MultiSink []Sink
+
+// Send delivers msg to each sink, reporting every failure
+func (m MultiSink) Send(ctx context.Context, msg Message) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Send(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken is returned for page tokens that are malformed or fail signature verification
+	ErrInvalidToken = errors.New("invalid page token")
+	// ErrExpiredToken is returned for page tokens issued longer ago than the codec TTL
+	ErrExpiredToken = errors.New("page token expired")
+	// ErrScopeMismatch is returned when a page token is reused with different filters or order
+	ErrScopeMismatch = errors.New("page token does not match the request")
+)
+
+// tokenPayload is the signed content of a page token
+type tokenPayload struct {
+	Cursor    json.RawMessage `json:"c"`
+	Scope     string          `json:"q"`
+	ExpiresAt int64           `json:"e"`
+}
+
+// TokenCodec encodes keyset cursors into opaque, HMAC-signed page tokens.
+// The cursor type is chosen by the caller and must round-trip through encoding/json.
+type TokenCodec struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewTokenCodec creates a codec signing tokens with secret; tokens are valid for ttl.
+// An empty secret is replaced by a random one, so tokens only survive for the process lifetime.
+func NewTokenCodec(secret []byte, ttl time.Duration) (*TokenCodec, error) {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate page token secret: %w", err)
+		}
+	}
+	return &TokenCodec{secret: secret, ttl: ttl, now: time.Now}, nil
+}
+
+// Encode turns a JSON-serializable cursor into an opaque page token bound to scope,
+// typically models.ListTasksRequest.QueryKey
+func (c *TokenCodec) Encode(cursor interface{}, scope string) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	payload, err := json.Marshal(tokenPayload{
+		Cursor:    raw,
+		Scope:     scope,
+		ExpiresAt: c.now().Add(c.ttl).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + base64.RawURLEncoding.EncodeToString(c.sign(body)), nil
+}
+
+// Decode verifies a page token issued for scope and unmarshals its cursor into dst
+func (c *TokenCodec) Decode(token string, scope string, dst interface{}) error {
+	body, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidToken
+	}
+
+	gotSig, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(gotSig, c.sign(body)) {
+		return ErrInvalidToken
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	var payload tokenPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return ErrInvalidToken
+	}
+
+	if payload.Scope != scope {
+		return ErrScopeMismatch
+	}
+
+	if c.now().Unix() > payload.ExpiresAt {
+		return ErrExpiredToken
+	}
+
+	if err := json.Unmarshal(payload.Cursor, dst); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// sign computes the HMAC-SHA256 of the token body
+func (c *TokenCodec) sign(body string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
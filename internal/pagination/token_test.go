@@ -0,0 +1,164 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// newTestCodec returns a codec whose clock the test moves by setting *now
+func newTestCodec(t *testing.T, secret string, ttl time.Duration) (*TokenCodec, *time.Time) {
+	t.Helper()
+	codec, err := NewTokenCodec([]byte(secret), ttl)
+	if err != nil {
+		t.Fatalf("NewTokenCodec: %v", err)
+	}
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	codec.now = func() time.Time { return now }
+	return codec, &now
+}
+
+func TestTokenRoundTrip(t *testing.T) {
+	codec, _ := newTestCodec(t, "secret", time.Hour)
+	want := testCursor{CreatedAt: time.Date(2026, 1, 1, 8, 0, 0, 123000, time.UTC), ID: "task-1"}
+
+	token, err := codec.Encode(want, "scope")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	var got testCursor
+	if err := codec.Decode(token, "scope", &got); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || got.ID != want.ID {
+		t.Errorf("Decode = %+v, want %+v", got, want)
+	}
+}
+
+func TestTamperedTokens(t *testing.T) {
+	codec, _ := newTestCodec(t, "secret", time.Hour)
+	token, err := codec.Encode(testCursor{ID: "task-1"}, "scope")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	body, sig, _ := strings.Cut(token, ".")
+
+	// A body naming another task, signed with a different secret
+	other, _ := newTestCodec(t, "other secret", time.Hour)
+	forged, err := other.Encode(testCursor{ID: "task-2"}, "scope")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	forgedBody, forgedSig, _ := strings.Cut(forged, ".")
+
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	edited := base64.RawURLEncoding.EncodeToString([]byte(strings.Replace(string(payload), "task-1", "task-9", 1)))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"no signature", body},
+		{"empty signature", body + "."},
+		{"edited body", edited + "." + sig},
+		{"swapped body", forgedBody + "." + sig},
+		{"other secret", forged},
+		{"other signature", body + "." + forgedSig},
+		{"truncated signature", body + "." + sig[:len(sig)-2]},
+		{"signature not base64", body + ".!!!"},
+		{"extra part", token + ".x"},
+		{"signed garbage", signed(codec, "not json")},
+		{"signed body not base64", "%%%." + base64.RawURLEncoding.EncodeToString(codec.sign("%%%"))},
+	}
+	for _, tt := range tests {
+		var cursor testCursor
+		if err := codec.Decode(tt.token, "scope", &cursor); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: Decode = %v (cursor %+v), want ErrInvalidToken", tt.name, err, cursor)
+		}
+	}
+}
+
+func TestCursorOfWrongType(t *testing.T) {
+	codec, _ := newTestCodec(t, "secret", time.Hour)
+	token, err := codec.Encode([]int{1, 2}, "scope")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	var cursor testCursor
+	if err := codec.Decode(token, "scope", &cursor); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Decode into the wrong type = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestExpiredToken(t *testing.T) {
+	codec, now := newTestCodec(t, "secret", time.Hour)
+	token, err := codec.Encode(testCursor{ID: "task-1"}, "scope")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	var cursor testCursor
+	*now = now.Add(time.Hour)
+	if err := codec.Decode(token, "scope", &cursor); err != nil {
+		t.Errorf("Decode at the end of the TTL: %v", err)
+	}
+	*now = now.Add(time.Second)
+	if err := codec.Decode(token, "scope", &cursor); !errors.Is(err, ErrExpiredToken) {
+		t.Errorf("Decode after the TTL = %v, want ErrExpiredToken", err)
+	}
+}
+
+func TestScopeMismatch(t *testing.T) {
+	codec, _ := newTestCodec(t, "secret", time.Hour)
+	token, err := codec.Encode(testCursor{ID: "task-1"}, `completed=false;order=created_at`)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	for _, scope := range []string{`completed=true;order=created_at`, `completed=false;order=title`, ""} {
+		var cursor testCursor
+		if err := codec.Decode(token, scope, &cursor); !errors.Is(err, ErrScopeMismatch) {
+			t.Errorf("Decode for scope %q = %v, want ErrScopeMismatch", scope, err)
+		}
+	}
+}
+
+func TestRandomSecret(t *testing.T) {
+	first, err := NewTokenCodec(nil, time.Hour)
+	if err != nil {
+		t.Fatalf("NewTokenCodec: %v", err)
+	}
+	second, err := NewTokenCodec(nil, time.Hour)
+	if err != nil {
+		t.Fatalf("NewTokenCodec: %v", err)
+	}
+
+	token, err := first.Encode(testCursor{ID: "task-1"}, "scope")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	var cursor testCursor
+	if err := first.Decode(token, "scope", &cursor); err != nil {
+		t.Errorf("Decode with the issuing codec: %v", err)
+	}
+	if err := second.Decode(token, "scope", &cursor); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Decode with another random secret = %v, want ErrInvalidToken", err)
+	}
+}
+
+// signed returns a token whose body is payload, correctly signed by codec
+func signed(codec *TokenCodec, payload string) string {
+	body := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return body + "." + base64.RawURLEncoding.EncodeToString(codec.sign(body))
+}
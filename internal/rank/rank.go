@@ -0,0 +1,267 @@
+// Package rank generates fractional ranking keys: strings that sort in list order
+// byte by byte, so an item can be placed between any two others by giving it a key
+// between theirs without renumbering the rest.
+//
+// A key is an integer part followed by an optional fraction, both in base 62. The
+// first character of the integer part encodes its length ('a' is two characters, 'b'
+// three, ..., and 'Z', 'Y', ... the same for negative integers), so appending at
+// either end only grows keys logarithmically. Repeated inserts at the same spot grow
+// the fraction by about one character per six inserts; Spread renumbers a list once
+// its keys get longer than MaxLength. Keys must be compared bytewise, which in
+// PostgreSQL means the "C" collation.
+package rank
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// digits are the base 62 digits in ascending byte order
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+// MaxLength is the key length beyond which a list should be spread out again
+const MaxLength = 24
+
+// smallestInteger is the lowest integer part; it must keep a fraction so keys can be
+// generated before it
+const smallestInteger = "A00000000000000000000000000"
+
+// zero is the key of the first item of an empty list
+const zero = "a0"
+
+// spreadHead is the integer head of the keys from Key and Spread: six characters,
+// enough for 62^5 items
+const spreadHead = 'e'
+
+var (
+	// ErrInvalidKey is returned for strings that are not well-formed keys
+	ErrInvalidKey = errors.New("invalid rank key")
+	// ErrOutOfOrder is returned when the lower bound does not sort before the upper one,
+	// for example when two items share a key; spreading the list resolves it
+	ErrOutOfOrder = errors.New("rank keys out of order")
+)
+
+// Between returns a key that sorts after a and before b. An empty a means the start
+// of the list and an empty b its end, so Between("", "") is the first key of a list.
+func Between(a, b string) (string, error) {
+	if a != "" {
+		if err := validate(a); err != nil {
+			return "", err
+		}
+	}
+	if b != "" {
+		if err := validate(b); err != nil {
+			return "", err
+		}
+	}
+	if a != "" && b != "" && a >= b {
+		return "", fmt.Errorf("%q is not before %q: %w", a, b, ErrOutOfOrder)
+	}
+
+	switch {
+	case a == "" && b == "":
+		return zero, nil
+	case a == "":
+		ib := integerPart(b)
+		if ib == smallestInteger {
+			return ib + midpoint("", b[len(ib):], false), nil
+		}
+		if ib < b {
+			return ib, nil
+		}
+		if i, ok := decrement(ib); ok {
+			return i, nil
+		}
+		return "", fmt.Errorf("no key before %q: %w", b, ErrInvalidKey)
+	case b == "":
+		ia := integerPart(a)
+		if i, ok := increment(ia); ok {
+			return i, nil
+		}
+		return ia + midpoint(a[len(ia):], "", true), nil
+	}
+
+	ia, ib := integerPart(a), integerPart(b)
+	if ia == ib {
+		return ia + midpoint(a[len(ia):], b[len(ib):], false), nil
+	}
+	i, ok := increment(ia)
+	if !ok {
+		return "", fmt.Errorf("no key after %q: %w", a, ErrInvalidKey)
+	}
+	if i < b {
+		return i, nil
+	}
+	return ia + midpoint(a[len(ia):], "", true), nil
+}
+
+// Key returns the key of the n-th item of a freshly spread list. Keys for different n
+// all have the same length and sort in the order of n.
+func Key(n int64) string {
+	key := make([]byte, 6)
+	key[0] = spreadHead
+	for i := len(key) - 1; i > 0; i-- {
+		key[i] = digits[n%int64(base)]
+		n /= int64(base)
+	}
+	return string(key)
+}
+
+// Spread returns n evenly spaced keys in ascending order
+func Spread(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = Key(int64(i))
+	}
+	return keys
+}
+
+// Valid reports whether key is a well-formed key
+func Valid(key string) bool {
+	return validate(key) == nil
+}
+
+// validate checks the integer head, the integer length and the fraction's digits
+func validate(key string) error {
+	n, ok := integerLength(key)
+	if !ok || n > len(key) || key == smallestInteger {
+		return fmt.Errorf("%q: %w", key, ErrInvalidKey)
+	}
+	for i := 1; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return fmt.Errorf("%q: %w", key, ErrInvalidKey)
+		}
+	}
+	if len(key) > n && key[len(key)-1] == digits[0] {
+		return fmt.Errorf("%q has a trailing zero: %w", key, ErrInvalidKey)
+	}
+	return nil
+}
+
+// integerLength returns the length of key's integer part from its head character
+func integerLength(key string) (int, bool) {
+	if key == "" {
+		return 0, false
+	}
+	switch head := key[0]; {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2, true
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2, true
+	}
+	return 0, false
+}
+
+// integerPart returns the integer part of a valid key
+func integerPart(key string) string {
+	n, _ := integerLength(key)
+	return key[:n]
+}
+
+// increment returns the next integer part, or false past the largest one
+func increment(x string) (string, bool) {
+	head, ds := x[0], []byte(x[1:])
+	for i := len(ds) - 1; i >= 0; i-- {
+		d := strings.IndexByte(digits, ds[i]) + 1
+		if d < base {
+			ds[i] = digits[d]
+			return string(head) + string(ds), true
+		}
+		ds[i] = digits[0]
+	}
+
+	// Every digit carried: move to the next integer length
+	switch head {
+	case 'Z':
+		return zero, true
+	case 'z':
+		return "", false
+	}
+	head++
+	if head > 'a' {
+		ds = append(ds, digits[0])
+	} else {
+		ds = ds[:len(ds)-1]
+	}
+	return string(head) + string(ds), true
+}
+
+// decrement returns the previous integer part, or false below the smallest one
+func decrement(x string) (string, bool) {
+	head, ds := x[0], []byte(x[1:])
+	for i := len(ds) - 1; i >= 0; i-- {
+		d := strings.IndexByte(digits, ds[i]) - 1
+		if d >= 0 {
+			ds[i] = digits[d]
+			return string(head) + string(ds), true
+		}
+		ds[i] = digits[base-1]
+	}
+
+	// Every digit borrowed: move to the previous integer length
+	switch head {
+	case 'a':
+		return "Z" + digits[base-1:], true
+	case 'A':
+		return "", false
+	}
+	head--
+	if head < 'Z' {
+		ds = append(ds, digits[base-1])
+	} else {
+		ds = ds[:len(ds)-1]
+	}
+	return string(head) + string(ds), true
+}
+
+// midpoint returns a fraction between fractions a and b, where open means b is the
+// end of the range. a must sort before b and neither may end in a zero digit.
+func midpoint(a, b string, open bool) string {
+	if !open {
+		// Keep the common prefix, reading missing digits of a as zeros
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:], false)
+		}
+	}
+
+	da := 0
+	if a != "" {
+		da = strings.IndexByte(digits, a[0])
+	}
+	db := base
+	if !open {
+		db = strings.IndexByte(digits, b[0])
+	}
+	if db-da > 1 {
+		return string(digits[(da+db+1)/2])
+	}
+
+	// The first digits are adjacent: a shorter b is enough, otherwise go one digit deeper
+	if !open && len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[da]) + midpoint(suffix(a, 1), "", true)
+}
+
+// digitAt returns the i-th digit of s, reading past its end as zero
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+// suffix returns s without its first n bytes, or "" when it is shorter
+func suffix(s string, n int) string {
+	if n < len(s) {
+		return s[n:]
+	}
+	return ""
+}
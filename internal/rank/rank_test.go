@@ -0,0 +1,256 @@
+package rank
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"", "", "a0"},
+		{"a0", "", "a1"},
+		{"", "a0", "Zz"},
+		{"a0", "a1", "a0V"},
+		{"a0", "a0V", "a0G"},
+		{"a0V", "a1", "a0l"},
+		{"az", "", "b00"},
+		{"Zz", "a0", "ZzV"},
+		{"", "b00", "az"},
+		{"a0", "a2", "a1"},
+		{"a0z", "a1", "a0zV"},
+		{"a0V", "a0W", "a0VV"},
+		{"a0VV", "a0W", "a0Vl"},
+	}
+	for _, tt := range tests {
+		got, err := Between(tt.a, tt.b)
+		if err != nil {
+			t.Errorf("Between(%q, %q): %v", tt.a, tt.b, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Between(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+		checkBetween(t, tt.a, got, tt.b)
+	}
+}
+
+func TestBetweenErrors(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want error
+	}{
+		{"a1", "a0", ErrOutOfOrder},
+		{"a0", "a0", ErrOutOfOrder},
+		{"b00", "a1", ErrOutOfOrder},
+		{"x", "", ErrInvalidKey},
+		{"", "a", ErrInvalidKey},
+		{"a0", "a00", ErrInvalidKey},
+		{"a0!", "", ErrInvalidKey},
+		{"", "#0", ErrInvalidKey},
+		{smallestInteger, "", ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		if got, err := Between(tt.a, tt.b); !errors.Is(err, tt.want) {
+			t.Errorf("Between(%q, %q) = %q, %v; want %v", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
+
+func TestIncrementDecrement(t *testing.T) {
+	tests := []struct{ x, next string }{
+		{"a0", "a1"},
+		{"a9", "aA"},
+		{"az", "b00"},
+		{"bzz", "c000"},
+		{"Zz", "a0"},
+		{"Yzz", "Z0"},
+		{"Y00", "Y01"},
+	}
+	for _, tt := range tests {
+		if got, ok := increment(tt.x); !ok || got != tt.next {
+			t.Errorf("increment(%q) = %q, %t; want %q", tt.x, got, ok, tt.next)
+		}
+		if got, ok := decrement(tt.next); !ok || got != tt.x {
+			t.Errorf("decrement(%q) = %q, %t; want %q", tt.next, got, ok, tt.x)
+		}
+	}
+	if _, ok := increment("z" + strings.Repeat("z", 26)); ok {
+		t.Error("increment of the largest integer succeeded")
+	}
+	if _, ok := decrement(smallestInteger); ok {
+		t.Error("decrement of the smallest integer succeeded")
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	tests := []struct {
+		a, b string
+		open bool
+		want string
+	}{
+		{"", "", true, "V"},
+		{"", "V", false, "G"},
+		{"1", "2", false, "1V"},
+		{"1", "12", false, "11"},
+		{"z", "", true, "zV"},
+		{"zz", "", true, "zzV"},
+		{"0001", "0002", false, "0001V"},
+	}
+	for _, tt := range tests {
+		got := midpoint(tt.a, tt.b, tt.open)
+		if got != tt.want {
+			t.Errorf("midpoint(%q, %q, %t) = %q, want %q", tt.a, tt.b, tt.open, got, tt.want)
+		}
+		if got <= tt.a || (!tt.open && got >= tt.b) {
+			t.Errorf("midpoint(%q, %q, %t) = %q is not between them", tt.a, tt.b, tt.open, got)
+		}
+	}
+}
+
+func TestRandomInserts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var keys []string
+	for n := 0; n < 5000; n++ {
+		i := rng.Intn(len(keys) + 1)
+		key, err := Between(at(keys, i-1), at(keys, i))
+		if err != nil {
+			t.Fatalf("insert %d at %d of %d: %v", n, i, len(keys), err)
+		}
+		checkBetween(t, at(keys, i-1), key, at(keys, i))
+		keys = slices.Insert(keys, i, key)
+	}
+	if !slices.IsSorted(keys) {
+		t.Fatal("keys are not sorted")
+	}
+	if len(slices.Compact(slices.Clone(keys))) != len(keys) {
+		t.Fatal("two inserts got the same key")
+	}
+}
+
+func TestRepeatedPrependAndAppend(t *testing.T) {
+	const n = 10000
+
+	first := ""
+	last := ""
+	for i := 0; i < n; i++ {
+		prepended, err := Between("", first)
+		if err != nil {
+			t.Fatalf("prepend %d before %q: %v", i, first, err)
+		}
+		checkBetween(t, "", prepended, first)
+		first = prepended
+
+		appended, err := Between(last, "")
+		if err != nil {
+			t.Fatalf("append %d after %q: %v", i, last, err)
+		}
+		checkBetween(t, last, appended, "")
+		last = appended
+	}
+
+	// Integer parts grow logarithmically: 62^3 items fit in four characters
+	if len(first) > 4 || len(last) > 4 {
+		t.Errorf("after %d prepends and appends the keys are %q and %q, want at most 4 characters", n, first, last)
+	}
+}
+
+func TestRepeatedInsertAtSameSpot(t *testing.T) {
+	// Inserting right after the same item keeps halving the gap above it
+	low, high := "a0", "a1"
+	inserts := 0
+	for len(high) <= MaxLength {
+		key, err := Between(low, high)
+		if err != nil {
+			t.Fatalf("insert %d between %q and %q: %v", inserts, low, high, err)
+		}
+		checkBetween(t, low, key, high)
+		high = key
+		inserts++
+	}
+	// About six inserts per character of fraction
+	if inserts < 5*(MaxLength-2) {
+		t.Errorf("keys outgrew MaxLength after only %d inserts", inserts)
+	}
+
+	// The same below an item
+	low, high = "a0", "a1"
+	for i := 0; i < 200; i++ {
+		key, err := Between(low, high)
+		if err != nil {
+			t.Fatalf("insert %d between %q and %q: %v", i, low, high, err)
+		}
+		checkBetween(t, low, key, high)
+		low = key
+	}
+}
+
+func TestSpread(t *testing.T) {
+	if Key(0) != "e00000" || Key(1) != "e00001" || Key(62) != "e00010" {
+		t.Errorf("Key(0), Key(1), Key(62) = %q, %q, %q", Key(0), Key(1), Key(62))
+	}
+
+	keys := Spread(5000)
+	if !slices.IsSorted(keys) {
+		t.Fatal("Spread keys are not sorted")
+	}
+	for i, key := range keys {
+		if !Valid(key) || len(key) != 6 {
+			t.Fatalf("Spread key %d = %q, want a valid six-character key", i, key)
+		}
+		if i > 0 && keys[i-1] == key {
+			t.Fatalf("Spread keys %d and %d are both %q", i-1, i, key)
+		}
+	}
+
+	// There is room between any two adjacent keys and at both ends
+	for i := 0; i <= len(keys); i++ {
+		a, b := at(keys, i-1), at(keys, i)
+		key, err := Between(a, b)
+		if err != nil {
+			t.Fatalf("Between(%q, %q): %v", a, b, err)
+		}
+		checkBetween(t, a, key, b)
+		if len(key) > 8 {
+			t.Errorf("Between(%q, %q) = %q, want a short key", a, b, key)
+		}
+	}
+}
+
+func TestSmallestKeys(t *testing.T) {
+	// Prepending past the smallest integer falls back to fractions
+	first := smallestInteger + "1"
+	for i := 0; i < 100; i++ {
+		key, err := Between("", first)
+		if err != nil {
+			t.Fatalf("prepend %d before %q: %v", i, first, err)
+		}
+		checkBetween(t, "", key, first)
+		first = key
+	}
+}
+
+// at returns keys[i], or "" past either end
+func at(keys []string, i int) string {
+	if i < 0 || i >= len(keys) {
+		return ""
+	}
+	return keys[i]
+}
+
+// checkBetween fails unless key is valid and sorts strictly between a and b, where ""
+// stands for either end of the list
+func checkBetween(t *testing.T, a, key, b string) {
+	t.Helper()
+	if !Valid(key) {
+		t.Fatalf("key %q between %q and %q is not valid", key, a, b)
+	}
+	if (a != "" && key <= a) || (b != "" && key >= b) {
+		t.Fatalf("key %q is not between %q and %q", key, a, b)
+	}
+}
@@ -0,0 +1,268 @@
+// Package recurrence parses and expands the subset of RFC 5545 recurrence rules that
+// recurring tasks use: FREQ=DAILY, WEEKLY or MONTHLY with INTERVAL, BYDAY, COUNT and
+// UNTIL, for example "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10".
+//
+// As in RFC 5545, the start of a series is its first occurrence whether or not it
+// matches the rule, COUNT includes it, and weeks start on Monday. BYDAY narrows daily
+// rules to some weekdays; monthly rules may number them, as in 2TU for the second
+// Tuesday or -1FR for the last Friday. Occurrences keep the start's time of day in its
+// location, and monthly days a month lacks, like the 31st, are skipped. A time of day a
+// clock change skips, like 2:30 as clocks go from 2:00 to 3:00, happens at 3:30 that
+// day. A date-only UNTIL lasts until the end of that day in UTC.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the period a rule repeats in
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// maxNumber bounds INTERVAL and COUNT
+const maxNumber = 10000
+
+// maxPeriods bounds the periods searched for occurrences, so a rule that matches
+// rarely cannot keep a caller busy
+const maxPeriods = 100000
+
+// ErrInvalidRule is returned for rules outside the supported subset
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// weekdays maps BYDAY codes to weekdays
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// Day is an entry of BYDAY
+type Day struct {
+	Weekday time.Weekday
+	N       int // 0 for every such weekday; 1 to 5 for one of them in the month, -1 to -5 counting from its end
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq     Frequency
+	Interval int       // periods from one occurrence's to the next; at least 1
+	ByDay    []Day     // empty repeats on the start's weekday or day of the month
+	Count    int       // occurrences in the series; 0 for no limit
+	Until    time.Time // no occurrence is later; zero for no limit
+}
+
+// Parse parses a rule such as "FREQ=DAILY;COUNT=5", with or without an "RRULE:" prefix
+func Parse(s string) (*Rule, error) {
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(strings.TrimPrefix(strings.ToUpper(s), "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: %q is not NAME=VALUE", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s is given more than once", ErrInvalidRule, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq = Frequency(value)
+			if rule.Freq != Daily && rule.Freq != Weekly && rule.Freq != Monthly {
+				err = fmt.Errorf("%w: FREQ must be DAILY, WEEKLY or MONTHLY", ErrInvalidRule)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parseNumber(name, value)
+		case "COUNT":
+			rule.Count, err = parseNumber(name, value)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		case "BYDAY":
+			rule.ByDay, err = parseDays(value)
+		default:
+			err = fmt.Errorf("%w: %s is not supported", ErrInvalidRule, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if rule.Count != 0 && !rule.Until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot both be given", ErrInvalidRule)
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != Monthly {
+			return nil, fmt.Errorf("%w: only monthly rules can number weekdays in BYDAY", ErrInvalidRule)
+		}
+	}
+	return rule, nil
+}
+
+// parseNumber parses the value of INTERVAL or COUNT
+func parseNumber(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 || n > maxNumber {
+		return 0, fmt.Errorf("%w: %s must be a number from 1 to %d", ErrInvalidRule, name, maxNumber)
+	}
+	return n, nil
+}
+
+// parseUntil parses a UTC date-time such as 20260131T170000Z, or a date
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("%w: UNTIL must be a date like 20260131 or a UTC time like 20260131T170000Z", ErrInvalidRule)
+}
+
+// parseDays parses the value of BYDAY, such as MO,WE or 1MO,-1FR
+func parseDays(value string) ([]Day, error) {
+	var days []Day
+	for _, code := range strings.Split(value, ",") {
+		if len(code) < 2 {
+			return nil, fmt.Errorf("%w: %q is not a BYDAY weekday", ErrInvalidRule, code)
+		}
+		weekday, ok := weekdays[code[len(code)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not a BYDAY weekday", ErrInvalidRule, code)
+		}
+		day := Day{Weekday: weekday}
+		if prefix := code[:len(code)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("%w: %q must number its weekday from 1 to 5 or -1 to -5", ErrInvalidRule, code)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// Occurrences returns up to n occurrences of the series that starts at start, the
+// first being start itself. COUNT and UNTIL may end the series sooner.
+func (r *Rule) Occurrences(start time.Time, n int) []time.Time {
+	if r.Count != 0 {
+		n = min(n, r.Count)
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	occurrences := []time.Time{start}
+	for p := 0; len(occurrences) < n && p < maxPeriods; p++ {
+		for _, t := range r.period(start, p) {
+			if !t.After(start) {
+				continue
+			}
+			if !r.Until.IsZero() && t.After(r.Until) {
+				return occurrences
+			}
+			occurrences = append(occurrences, t)
+			if len(occurrences) == n {
+				return occurrences
+			}
+		}
+	}
+	return occurrences
+}
+
+// period returns the times the rule matches in the p-th period of the series that
+// starts at start, in order
+func (r *Rule) period(start time.Time, p int) []time.Time {
+	year, month, day := start.Date()
+	at := func(year int, month time.Month, day int) time.Time {
+		return wallClock(year, month, day, start)
+	}
+
+	var times []time.Time
+	switch r.Freq {
+	case Daily:
+		t := at(year, month, day+p*r.Interval)
+		if len(r.ByDay) == 0 || r.onWeekday(t.Weekday()) {
+			times = append(times, t)
+		}
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			times = append(times, at(year, month, day+p*r.Interval*7))
+			break
+		}
+		// Weeks start on Monday
+		monday := day - (int(start.Weekday())+6)%7 + p*r.Interval*7
+		for offset := 0; offset < 7; offset++ {
+			if t := at(year, month, monday+offset); r.onWeekday(t.Weekday()) {
+				times = append(times, t)
+			}
+		}
+	case Monthly:
+		first := at(year, month+time.Month(p*r.Interval), 1)
+		length := first.AddDate(0, 1, -1).Day()
+		if len(r.ByDay) == 0 {
+			if day <= length {
+				times = append(times, at(first.Year(), first.Month(), day))
+			}
+			break
+		}
+		for d := 1; d <= length; d++ {
+			if t := at(first.Year(), first.Month(), d); r.onMonthDay(t.Weekday(), d, length) {
+				times = append(times, t)
+			}
+		}
+	}
+	return times
+}
+
+// wallClock returns start's time of day on the given date in start's location. When
+// clocks skip that time that day it is read with the offset from before they changed,
+// as RFC 5545 asks; time.Date leaves the choice of offset open.
+func wallClock(year int, month time.Month, day int, start time.Time) time.Time {
+	t := time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	want := time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), time.UTC)
+	got := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	if skipped := want.Sub(got); skipped > 0 {
+		// t was read with the offset from after the change, which puts it before the gap
+		t = t.Add(skipped)
+	}
+	return t
+}
+
+// onWeekday reports whether BYDAY lists weekday
+func (r *Rule) onWeekday(weekday time.Weekday) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// onMonthDay reports whether BYDAY matches day d, a weekday, of a month of length days
+func (r *Rule) onMonthDay(weekday time.Weekday, d, length int) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday != weekday {
+			continue
+		}
+		switch {
+		case day.N == 0,
+			day.N > 0 && (d-1)/7+1 == day.N,
+			day.N < 0 && (length-d)/7+1 == -day.N:
+			return true
+		}
+	}
+	return false
+}
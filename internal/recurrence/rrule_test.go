@@ -0,0 +1,243 @@
+package recurrence
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata" // the DST cases need America/New_York wherever the tests run
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		rule string
+		want Rule
+	}{
+		{"FREQ=DAILY", Rule{Freq: Daily, Interval: 1}},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10", Rule{
+			Freq: Weekly, Interval: 2, Count: 10,
+			ByDay: []Day{{Weekday: time.Monday}, {Weekday: time.Thursday}},
+		}},
+		{"freq=monthly;byday=2tu,-1fr", Rule{
+			Freq: Monthly, Interval: 1,
+			ByDay: []Day{{Weekday: time.Tuesday, N: 2}, {Weekday: time.Friday, N: -1}},
+		}},
+		{"FREQ=DAILY;UNTIL=20260131T170000Z", Rule{
+			Freq: Daily, Interval: 1, Until: time.Date(2026, 1, 31, 17, 0, 0, 0, time.UTC),
+		}},
+		// A date-only UNTIL lasts until the end of the day
+		{"FREQ=DAILY;UNTIL=20260131", Rule{
+			Freq: Daily, Interval: 1, Until: time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC),
+		}},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.rule)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.rule, err)
+			continue
+		}
+		if got.Freq != tt.want.Freq || got.Interval != tt.want.Interval || got.Count != tt.want.Count ||
+			!got.Until.Equal(tt.want.Until) || !slices.Equal(got.ByDay, tt.want.ByDay) {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.rule, *got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"COUNT=3",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=10001",
+		"FREQ=DAILY;COUNT=x",
+		"FREQ=DAILY;COUNT=3;UNTIL=20260131",
+		"FREQ=DAILY;UNTIL=2026-01-31",
+		"FREQ=DAILY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=2TU",
+		"FREQ=MONTHLY;BYDAY=6TU",
+		"FREQ=MONTHLY;BYDAY=0TU",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=DAILY;COUNT",
+	}
+	for _, rule := range tests {
+		if got, err := Parse(rule); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("Parse(%q) = %+v, %v; want ErrInvalidRule", rule, got, err)
+		}
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	utc := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		n     int
+		want  []time.Time
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY;INTERVAL=3",
+			start: utc(2026, 1, 30, 9),
+			n:     3,
+			want:  []time.Time{utc(2026, 1, 30, 9), utc(2026, 2, 2, 9), utc(2026, 2, 5, 9)},
+		},
+		{
+			name:  "second Tuesday",
+			rule:  "FREQ=MONTHLY;BYDAY=2TU",
+			start: utc(2026, 1, 13, 9),
+			n:     4,
+			want:  []time.Time{utc(2026, 1, 13, 9), utc(2026, 2, 10, 9), utc(2026, 3, 10, 9), utc(2026, 4, 14, 9)},
+		},
+		{
+			name:  "last Friday",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: utc(2026, 1, 30, 9),
+			n:     4,
+			want:  []time.Time{utc(2026, 1, 30, 9), utc(2026, 2, 27, 9), utc(2026, 3, 27, 9), utc(2026, 4, 24, 9)},
+		},
+		{
+			name:  "fifth Monday skips months without one",
+			rule:  "FREQ=MONTHLY;BYDAY=5MO",
+			start: utc(2026, 1, 1, 9),
+			n:     3,
+			want:  []time.Time{utc(2026, 1, 1, 9), utc(2026, 3, 30, 9), utc(2026, 6, 29, 9)},
+		},
+		{
+			name:  "the 31st skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: utc(2026, 1, 31, 9),
+			n:     5,
+			want:  []time.Time{utc(2026, 1, 31, 9), utc(2026, 3, 31, 9), utc(2026, 5, 31, 9), utc(2026, 7, 31, 9), utc(2026, 8, 31, 9)},
+		},
+		{
+			name:  "date-only UNTIL includes that day",
+			rule:  "FREQ=DAILY;UNTIL=20260103",
+			start: utc(2026, 1, 1, 22),
+			n:     10,
+			want:  []time.Time{utc(2026, 1, 1, 22), utc(2026, 1, 2, 22), utc(2026, 1, 3, 22)},
+		},
+		{
+			name:  "UNTIL with a time",
+			rule:  "FREQ=DAILY;UNTIL=20260103T120000Z",
+			start: utc(2026, 1, 1, 22),
+			n:     10,
+			want:  []time.Time{utc(2026, 1, 1, 22), utc(2026, 1, 2, 22)},
+		},
+		{
+			name:  "COUNT includes the start",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: utc(2026, 1, 1, 9),
+			n:     10,
+			want:  []time.Time{utc(2026, 1, 1, 9), utc(2026, 1, 2, 9), utc(2026, 1, 3, 9)},
+		},
+		{
+			name:  "COUNT includes a start off the rule",
+			rule:  "FREQ=WEEKLY;BYDAY=MO;COUNT=3",
+			start: utc(2026, 1, 7, 9), // a Wednesday
+			n:     10,
+			want:  []time.Time{utc(2026, 1, 7, 9), utc(2026, 1, 12, 9), utc(2026, 1, 19, 9)},
+		},
+		{
+			// The Sunday after a Tuesday start is in the same week, so the second week
+			// skipped is the one starting Monday the 12th
+			name:  "weeks start on Monday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU",
+			start: utc(2026, 1, 6, 9),
+			n:     6,
+			want: []time.Time{
+				utc(2026, 1, 6, 9), utc(2026, 1, 11, 9), utc(2026, 1, 20, 9),
+				utc(2026, 1, 25, 9), utc(2026, 2, 3, 9), utc(2026, 2, 8, 9),
+			},
+		},
+		{
+			name:  "weekdays only",
+			rule:  "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start: utc(2026, 1, 8, 9), // a Thursday
+			n:     4,
+			want:  []time.Time{utc(2026, 1, 8, 9), utc(2026, 1, 9, 9), utc(2026, 1, 12, 9), utc(2026, 1, 13, 9)},
+		},
+		{
+			name:  "fewer than asked for",
+			rule:  "FREQ=DAILY",
+			start: utc(2026, 1, 1, 9),
+			n:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.rule, err)
+			}
+			checkTimes(t, rule.Occurrences(tt.start, tt.n), tt.want)
+		})
+	}
+}
+
+func TestOccurrencesAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	local := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, newYork)
+	}
+
+	daily, err := Parse("FREQ=DAILY")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// Clocks go forward on 8 March and back on 1 November, and the time of day stays put
+	spring := daily.Occurrences(local(time.March, 7, 9, 0), 3)
+	checkTimes(t, spring, []time.Time{local(time.March, 7, 9, 0), local(time.March, 8, 9, 0), local(time.March, 9, 9, 0)})
+	if gap := spring[1].Sub(spring[0]); gap != 23*time.Hour {
+		t.Errorf("9:00 to 9:00 over the spring change = %v, want 23h", gap)
+	}
+	autumn := daily.Occurrences(local(time.October, 31, 9, 0), 2)
+	checkTimes(t, autumn, []time.Time{local(time.October, 31, 9, 0), local(time.November, 1, 9, 0)})
+	if gap := autumn[1].Sub(autumn[0]); gap != 25*time.Hour {
+		t.Errorf("9:00 to 9:00 over the autumn change = %v, want 25h", gap)
+	}
+
+	// 2:30 does not exist on 8 March, so that day's occurrence moves to 3:30 daylight
+	// time; the next day is back at 2:30
+	skipped := daily.Occurrences(local(time.March, 7, 2, 30), 3)
+	checkTimes(t, skipped, []time.Time{
+		local(time.March, 7, 2, 30),
+		time.Date(2026, time.March, 8, 7, 30, 0, 0, time.UTC),
+		local(time.March, 9, 2, 30),
+	})
+
+	// Weekly occurrences keep their wall-clock time too
+	weekly, err := Parse("FREQ=WEEKLY;BYDAY=SU")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	checkTimes(t, weekly.Occurrences(local(time.October, 25, 18, 0), 3), []time.Time{
+		local(time.October, 25, 18, 0), local(time.November, 1, 18, 0), local(time.November, 8, 18, 0),
+	})
+}
+
+func TestOccurrencesRareRule(t *testing.T) {
+	// February only has a 5th Monday in leap years starting on a Monday
+	rule := &Rule{Freq: Monthly, Interval: 12, ByDay: []Day{{Weekday: time.Monday, N: 5}}}
+	start := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	checkTimes(t, rule.Occurrences(start, 3), []time.Time{
+		start, time.Date(2044, 2, 29, 9, 0, 0, 0, time.UTC), time.Date(2072, 2, 29, 9, 0, 0, 0, time.UTC),
+	})
+}
+
+// checkTimes fails unless got holds the same instants as want, in order
+func checkTimes(t *testing.T, got, want []time.Time) {
+	t.Helper()
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("occurrences = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,214 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Weights mirror the PostgreSQL ts_rank defaults for the A (title) and B (description) labels
+const (
+	TitleWeight       = 1.0
+	DescriptionWeight = 0.4
+)
+
+// Snippet markers, matching the ts_headline defaults
+const (
+	StartSel = "<b>"
+	StopSel  = "</b>"
+)
+
+// MaxSnippetWords bounds the length of a description snippet
+const MaxSnippetWords = 35
+
+// Tokenize lowercases text and splits it into alphanumeric words
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// clause is one OR-branch of a query: every term and phrase must match, no excluded word may
+type clause struct {
+	terms    []string
+	phrases  [][]string
+	excluded []string
+}
+
+// Query is a parsed web-search style query: words, "quoted phrases", -excluded words and OR
+type Query struct {
+	clauses []clause
+}
+
+// ParseQuery parses a search query the way websearch_to_tsquery does for the supported subset
+func ParseQuery(input string) *Query {
+	q := &Query{}
+	current := clause{}
+	rest := input
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			break
+		}
+
+		switch {
+		case rest[0] == '"':
+			end := strings.IndexByte(rest[1:], '"')
+			var phrase string
+			if end < 0 {
+				phrase, rest = rest[1:], ""
+			} else {
+				phrase, rest = rest[1:end+1], rest[end+2:]
+			}
+			if words := Tokenize(phrase); len(words) > 0 {
+				current.phrases = append(current.phrases, words)
+			}
+		default:
+			end := strings.IndexFunc(rest, unicode.IsSpace)
+			if end < 0 {
+				end = len(rest)
+			}
+			word := rest[:end]
+			rest = rest[end:]
+
+			switch {
+			case word == "or" || word == "OR":
+				if !current.empty() {
+					q.clauses = append(q.clauses, current)
+				}
+				current = clause{}
+			case strings.HasPrefix(word, "-"):
+				current.excluded = append(current.excluded, Tokenize(word[1:])...)
+			default:
+				current.terms = append(current.terms, Tokenize(word)...)
+			}
+		}
+	}
+	if !current.empty() {
+		q.clauses = append(q.clauses, current)
+	}
+	return q
+}
+
+// empty reports whether the clause has no positive conditions
+func (c clause) empty() bool {
+	return len(c.terms) == 0 && len(c.phrases) == 0
+}
+
+// Empty reports whether the query cannot match anything
+func (q *Query) Empty() bool {
+	return len(q.clauses) == 0
+}
+
+// Rank scores a document made of a title and description; 0 means no match
+func (q *Query) Rank(title, description string) float64 {
+	titleWords, descWords := Tokenize(title), Tokenize(description)
+	best := 0.0
+	for _, c := range q.clauses {
+		if score := c.rank(titleWords, descWords); score > best {
+			best = score
+		}
+	}
+	return best
+}
+
+func (c clause) rank(titleWords, descWords []string) float64 {
+	for _, word := range c.excluded {
+		if count(titleWords, word)+count(descWords, word) > 0 {
+			return 0
+		}
+	}
+
+	score := 0.0
+	for _, term := range c.terms {
+		hits := TitleWeight*float64(count(titleWords, term)) + DescriptionWeight*float64(count(descWords, term))
+		if hits == 0 {
+			return 0
+		}
+		score += hits
+	}
+	for _, phrase := range c.phrases {
+		hits := TitleWeight*float64(countPhrase(titleWords, phrase)) + DescriptionWeight*float64(countPhrase(descWords, phrase))
+		if hits == 0 {
+			return 0
+		}
+		score += hits
+	}
+	return score
+}
+
+// words returns every positive word of the query, for highlighting
+func (q *Query) words() map[string]bool {
+	words := make(map[string]bool)
+	for _, c := range q.clauses {
+		for _, term := range c.terms {
+			words[term] = true
+		}
+		for _, phrase := range c.phrases {
+			for _, word := range phrase {
+				words[word] = true
+			}
+		}
+	}
+	return words
+}
+
+// Snippet returns up to MaxSnippetWords words of text around the first match,
+// with matched words wrapped in StartSel and StopSel
+func (q *Query) Snippet(text string) string {
+	words := q.words()
+	fields := strings.Fields(text)
+
+	matches := func(field string) bool {
+		for _, token := range Tokenize(field) {
+			if words[token] {
+				return true
+			}
+		}
+		return false
+	}
+
+	start := 0
+	for i, field := range fields {
+		if matches(field) {
+			start = max(0, i-MaxSnippetWords/3)
+			break
+		}
+	}
+	end := min(len(fields), start+MaxSnippetWords)
+
+	out := make([]string, 0, end-start)
+	for _, field := range fields[start:end] {
+		if matches(field) {
+			field = StartSel + field + StopSel
+		}
+		out = append(out, field)
+	}
+	return strings.Join(out, " ")
+}
+
+func count(words []string, word string) int {
+	n := 0
+	for _, w := range words {
+		if w == word {
+			n++
+		}
+	}
+	return n
+}
+
+func countPhrase(words, phrase []string) int {
+	n := 0
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		match := true
+		for j, w := range phrase {
+			if words[i+j] != w {
+				match = false
+				break
+			}
+		}
+		if match {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,120 @@
+package search
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"Buy MILK", []string{"buy", "milk"}},
+		{"re-paint the shed, twice!", []string{"re", "paint", "the", "shed", "twice"}},
+		{"Q3 report_v2", []string{"q3", "report", "v2"}},
+		{"Café crème", []string{"café", "crème"}},
+		{"  ...  ", nil},
+	}
+	for _, tt := range tests {
+		if got := Tokenize(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []clause
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"Milk", []clause{{terms: []string{"milk"}}}},
+		{"buy milk", []clause{{terms: []string{"buy", "milk"}}}},
+		{"ice-cream", []clause{{terms: []string{"ice", "cream"}}}},
+		{`"Weekly Report" draft`, []clause{{terms: []string{"draft"}, phrases: [][]string{{"weekly", "report"}}}}},
+		{`"unterminated phrase`, []clause{{phrases: [][]string{{"unterminated", "phrase"}}}}},
+		{`"" milk`, []clause{{terms: []string{"milk"}}}},
+		{"milk -oat", []clause{{terms: []string{"milk"}, excluded: []string{"oat"}}}},
+		{"milk or bread", []clause{{terms: []string{"milk"}}, {terms: []string{"bread"}}}},
+		{"milk OR bread -stale", []clause{{terms: []string{"milk"}}, {terms: []string{"bread"}, excluded: []string{"stale"}}}},
+		// Branches without positive words are dropped
+		{"or milk or", []clause{{terms: []string{"milk"}}}},
+		{"-oat", nil},
+		{"milk or -oat", []clause{{terms: []string{"milk"}}}},
+		{"!!!", nil},
+	}
+	for _, tt := range tests {
+		got := ParseQuery(tt.input)
+		if !reflect.DeepEqual(got.clauses, tt.want) {
+			t.Errorf("ParseQuery(%q) = %+v, want %+v", tt.input, got.clauses, tt.want)
+		}
+		if got.Empty() != (len(tt.want) == 0) {
+			t.Errorf("ParseQuery(%q).Empty() = %t", tt.input, got.Empty())
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		query       string
+		title       string
+		description string
+		want        float64
+	}{
+		{"milk", "Buy milk", "", TitleWeight},
+		{"milk", "Groceries", "milk and eggs", DescriptionWeight},
+		{"milk", "Milk", "more milk, then milk", TitleWeight + 2*DescriptionWeight},
+		{"MILK", "buy milk", "", TitleWeight},
+		{"milk", "Buy bread", "", 0},
+		{"milk bread", "Buy milk", "", 0},
+		{"milk bread", "Buy milk", "and bread", TitleWeight + DescriptionWeight},
+		{`"buy milk"`, "Buy milk", "", TitleWeight},
+		{`"milk buy"`, "Buy milk", "", 0},
+		{"milk -oat", "Buy milk", "", TitleWeight},
+		{"milk -oat", "Buy milk", "the oat kind", 0},
+		// The best branch wins
+		{"bread or milk", "Buy milk", "and milk", TitleWeight + DescriptionWeight},
+		{"bread or milk", "Buy bread and milk", "", TitleWeight},
+		{"", "Buy milk", "", 0},
+	}
+	for _, tt := range tests {
+		if got := ParseQuery(tt.query).Rank(tt.title, tt.description); got != tt.want {
+			t.Errorf("Rank(%q) of %q / %q = %v, want %v", tt.query, tt.title, tt.description, got, tt.want)
+		}
+	}
+
+	// Title matches outrank the same number of description matches
+	q := ParseQuery("report")
+	if q.Rank("Quarterly report", "") <= q.Rank("Quarterly", "the report") {
+		t.Error("a title match does not outrank a description match")
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	q := ParseQuery(`milk "fresh bread"`)
+	if got, want := q.Snippet("Buy Milk, fresh bread and eggs"), "Buy <b>Milk,</b> <b>fresh</b> <b>bread</b> and eggs"; got != want {
+		t.Errorf("Snippet = %q, want %q", got, want)
+	}
+	if got := q.Snippet("nothing to see"); got != "nothing to see" {
+		t.Errorf("Snippet without a match = %q", got)
+	}
+
+	// Long text is cut to MaxSnippetWords around the first match
+	words := make([]string, 100)
+	for i := range words {
+		words[i] = "word"
+	}
+	words[60] = "milk"
+	snippet := strings.Fields(q.Snippet(strings.Join(words, " ")))
+	if len(snippet) != MaxSnippetWords {
+		t.Fatalf("snippet has %d words, want %d", len(snippet), MaxSnippetWords)
+	}
+	if i := slices.Index(snippet, StartSel+"milk"+StopSel); i != MaxSnippetWords/3 {
+		t.Errorf("match is word %d of the snippet, want %d", i, MaxSnippetWords/3)
+	}
+}
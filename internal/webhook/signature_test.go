@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignFormat(t *testing.T) {
+	at := time.Unix(1767225600, 0)
+	header := Sign("whsec_test", at, []byte(`{"id":1}`))
+	if !regexp.MustCompile(`^t=1767225600,v1=[0-9a-f]{64}$`).MatchString(header) {
+		t.Errorf("Sign = %q, want t=<unix>,v1=<64 hex digits>", header)
+	}
+	if Sign("whsec_test", at, []byte(`{"id":1}`)) != header {
+		t.Error("Sign is not deterministic")
+	}
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	secret, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(secret, secretPrefix) {
+		t.Errorf("NewSecret = %q, want the %s prefix", secret, secretPrefix)
+	}
+
+	body := []byte(`{"id":42,"type":"task.created"}`)
+	sent := time.Now()
+	header := Sign(secret, sent, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		body      []byte
+		tolerance time.Duration
+		now       time.Time
+		want      error
+	}{
+		{"valid", secret, header, body, 5 * time.Minute, sent.Add(time.Minute), nil},
+		{"no tolerance", secret, header, body, 0, sent.Add(24 * time.Hour), nil},
+		{"tampered body", secret, header, []byte(`{"id":43,"type":"task.created"}`), time.Minute, sent, ErrInvalidSignature},
+		{"wrong secret", "whsec_other", header, body, time.Minute, sent, ErrInvalidSignature},
+		{"stale", secret, header, body, 5 * time.Minute, sent.Add(6 * time.Minute), ErrStaleSignature},
+		{"from the future", secret, header, body, 5 * time.Minute, sent.Add(-6 * time.Minute), ErrStaleSignature},
+		{"rotated secret", secret, Sign("whsec_old", sent, body) + ",v1=" + strings.SplitN(header, "v1=", 2)[1], body, time.Minute, sent, nil},
+		{"spaces after commas", secret, strings.Replace(header, ",", ", ", 1), body, time.Minute, sent, nil},
+		{"missing timestamp", secret, header[strings.Index(header, "v1="):], body, time.Minute, sent, ErrInvalidSignature},
+		{"missing signature", secret, header[:strings.Index(header, ",")], body, time.Minute, sent, ErrInvalidSignature},
+		{"timestamp changed", secret, strings.Replace(header, "t=", "t=1", 1), body, 0, sent, ErrInvalidSignature},
+		{"not hex", secret, header[:strings.Index(header, "v1=")] + "v1=zz", body, time.Minute, sent, ErrInvalidSignature},
+		{"empty", secret, "", body, time.Minute, sent, ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.tolerance, tt.now)
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Errorf("Verify(%q) = %v, want %v", tt.header, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.215.14":         true,
+		"2606:2800:21f:cb07::1": true,
+		"127.0.0.1":             false,
+		"::1":                   false,
+		"10.1.2.3":              false,
+		"172.16.0.1":            false,
+		"192.168.1.1":           false,
+		"169.254.169.254":       false,
+		"fe80::1":               false,
+		"fd00::1":               false,
+		"0.0.0.0":               false,
+		"::":                    false,
+		"100.64.0.1":            false,
+		"224.0.0.1":             false,
+		"255.255.255.255":       false,
+		"::ffff:127.0.0.1":      false,
+		"::ffff:10.0.0.1":       false,
+	}
+	for addr, want := range tests {
+		if got := isPublic(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("isPublic(%s) = %t, want %t", addr, got, want)
+		}
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := map[string]bool{ // URL to whether it is accepted
+		"https://example.com/hook":           true,
+		"https://93.184.215.14/hook":         true,
+		"http://localhost:8080/hook":         false,
+		"http://LOCALHOST./hook":             false,
+		"http://api.localhost/hook":          false,
+		"http://127.0.0.1/hook":              false,
+		"http://[::1]:9000/hook":             false,
+		"http://10.0.0.5/hook":               false,
+		"http://169.254.169.254/latest/meta": false,
+		"http://[fd12::1]/hook":              false,
+		"http://0.0.0.0/hook":                false,
+	}
+	for url, want := range tests {
+		err := CheckURL(url)
+		if want && err != nil {
+			t.Errorf("CheckURL(%s) = %v, want nil", url, err)
+		}
+		if !want && !errors.Is(err, ErrPrivateTarget) {
+			t.Errorf("CheckURL(%s) = %v, want ErrPrivateTarget", url, err)
+		}
+	}
+}
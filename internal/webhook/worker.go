@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/outbox"
+)
+
+// maxErrorBody bounds how much of a failed response is kept in the delivery log
+const maxErrorBody = 256
+
+// userAgent identifies deliveries to receivers
+const userAgent = "TaskListAPI-Webhooks/1.0"
+
+// Store is the part of database.WebhookStore the worker needs
+type Store interface {
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, attempt *models.WebhookAttempt, policy models.WebhookDisablePolicy) (bool, error)
+}
+
+// Event is the JSON body of every delivery
+type Event struct {
+	ID        int64           `json:"id"`   // delivery ID, also sent as X-Webhook-Delivery
+	Type      string          `json:"type"` // e.g. "task.completed"
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"` // the change: task, field changes, actor and version
+}
+
+// Config tunes a Worker; zero values fall back to the defaults in NewWorker
+type Config struct {
+	Timeout      time.Duration // per request
+	Retry        outbox.RetryPolicy
+	Disable      models.WebhookDisablePolicy
+	BatchSize    int // deliveries claimed per poll
+	Concurrency  int // requests in flight at once
+	PollInterval time.Duration
+
+	AllowPrivateTargets bool // deliver to loopback, link-local and private addresses too
+}
+
+// DefaultRetryPolicy retries for about a day before giving up on a delivery
+var DefaultRetryPolicy = outbox.RetryPolicy{MaxAttempts: 15, BaseDelay: 10 * time.Second, MaxDelay: 4 * time.Hour}
+
+// Worker delivers queued webhook events
+type Worker struct {
+	store  Store
+	client *http.Client
+	cfg    Config
+}
+
+// NewWorker creates a worker delivering deliveries claimed from store
+func NewWorker(store Store, cfg Config) *Worker {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.Retry.MaxAttempts <= 0 {
+		cfg.Retry = DefaultRetryPolicy
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 8
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+
+	client := &http.Client{
+		Timeout: cfg.Timeout,
+		// A redirect is reported as a failure rather than re-sent somewhere else
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	if !cfg.AllowPrivateTargets {
+		// Any client can register a URL, so keep deliveries away from internal services
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.DialContext = publicDialer(cfg.Timeout)
+		client.Transport = transport
+	}
+	return &Worker{store: store, client: client, cfg: cfg}
+}
+
+// Run delivers due events until ctx is cancelled. A full batch is followed
+// immediately by the next one so a backlog clears without waiting for the interval.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := w.deliverBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to claim webhook deliveries: %v", err)
+		}
+		if err == nil && n == w.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverBatch claims one batch, attempts every delivery in it and records the
+// outcomes. It returns how many deliveries were claimed.
+func (w *Worker) deliverBatch(ctx context.Context) (int, error) {
+	// Lease long enough for the whole batch to go out at the configured concurrency
+	rounds := (w.cfg.BatchSize + w.cfg.Concurrency - 1) / w.cfg.Concurrency
+	deliveries, err := w.store.ClaimWebhookDeliveries(ctx, w.cfg.BatchSize, time.Duration(rounds+1)*w.cfg.Timeout)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, w.cfg.Concurrency)
+	for _, d := range deliveries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+
+			attempt := w.attempt(ctx, d)
+			// An attempt cut short by shutdown is retried once the lease expires
+			if ctx.Err() != nil {
+				return
+			}
+			disabled, err := w.store.RecordWebhookAttempt(ctx, attempt, w.cfg.Disable)
+			if err != nil {
+				log.Printf("Failed to record attempt %d of webhook delivery %d: %v", attempt.Attempt, d.ID, err)
+			} else if disabled {
+				log.Printf("Disabled webhook %s after repeated delivery failures", d.Webhook.ID)
+			}
+		}()
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// attempt POSTs d once and returns the outcome, with the next attempt scheduled by the
+// retry policy if it failed
+func (w *Worker) attempt(ctx context.Context, d *models.WebhookDelivery) *models.WebhookAttempt {
+	attempt := &models.WebhookAttempt{
+		DeliveryID:  d.ID,
+		WebhookID:   d.Webhook.ID,
+		EventType:   d.EventType,
+		TaskID:      d.TaskID,
+		Attempt:     d.Attempts + 1,
+		AttemptedAt: time.Now(),
+	}
+
+	attempt.StatusCode, attempt.Error = w.post(ctx, d, attempt.Attempt, attempt.AttemptedAt)
+	attempt.Duration = time.Since(attempt.AttemptedAt)
+
+	if !attempt.Succeeded() && !w.cfg.Retry.Exhausted(attempt.Attempt) {
+		next := attempt.AttemptedAt.Add(w.cfg.Retry.Delay(attempt.Attempt))
+		attempt.NextAttemptAt = &next
+	}
+	return attempt
+}
+
+// post sends d and returns the response status and, unless it was 2xx, an error message
+func (w *Worker) post(ctx context.Context, d *models.WebhookDelivery, attempt int, now time.Time) (int, string) {
+	body, err := json.Marshal(Event{ID: d.ID, Type: d.EventType, CreatedAt: d.CreatedAt, Data: d.Payload})
+	if err != nil {
+		return 0, fmt.Sprintf("failed to encode event: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Sprintf("failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderSignature, Sign(d.Webhook.Secret, now, body))
+	req.Header.Set(HeaderWebhookID, d.Webhook.ID)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(HeaderEvent, d.EventType)
+	req.Header.Set(HeaderAttempt, strconv.Itoa(attempt))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer resp.Body.Close()
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg := "endpoint responded " + resp.Status
+		if s := strings.TrimSpace(string(snippet)); s != "" {
+			msg += ": " + s
+		}
+		return resp.StatusCode, msg
+	}
+	return resp.StatusCode, ""
+}
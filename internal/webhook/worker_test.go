@@ -0,0 +1,245 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Samarth11-A/TaskListAPI/internal/database"
+	"github.com/Samarth11-A/TaskListAPI/internal/models"
+	"github.com/Samarth11-A/TaskListAPI/internal/outbox"
+)
+
+const testSecret = "whsec_0123456789abcdef"
+
+// receiver is an httptest endpoint answering every delivery with status and
+// checking its headers and signature
+type receiver struct {
+	*httptest.Server
+	hits atomic.Int32
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	t.Helper()
+	r := &receiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.hits.Add(1)
+		body, _ := io.ReadAll(req.Body)
+		if err := Verify(testSecret, req.Header.Get(HeaderSignature), body, time.Minute, time.Now()); err != nil {
+			t.Errorf("delivery signature: %v", err)
+		}
+		var ev Event
+		if err := json.Unmarshal(body, &ev); err != nil {
+			t.Errorf("delivery body %q: %v", body, err)
+		}
+		if got := req.Header.Get(HeaderDelivery); got != strconv.FormatInt(ev.ID, 10) {
+			t.Errorf("%s = %q, want the event ID %d", HeaderDelivery, got, ev.ID)
+		}
+		if got := req.Header.Get(HeaderEvent); got != ev.Type {
+			t.Errorf("%s = %q, want %q", HeaderEvent, got, ev.Type)
+		}
+		if req.Header.Get(HeaderWebhookID) == "" || req.Header.Get(HeaderAttempt) == "" {
+			t.Errorf("delivery is missing %s or %s", HeaderWebhookID, HeaderAttempt)
+		}
+		w.WriteHeader(status)
+		io.WriteString(w, "receiver says "+http.StatusText(status))
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// testDelivery is a delivery to url that has failed attempts times already
+func testDelivery(url string, attempts int) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:        7,
+		Webhook:   &models.Webhook{ID: "hook", URL: url, Secret: testSecret},
+		EventType: models.EventTaskCreated,
+		TaskID:    "task",
+		Payload:   json.RawMessage(`{"task":{"id":"task"}}`),
+		CreatedAt: time.Now(),
+		Attempts:  attempts,
+	}
+}
+
+func TestAttemptSucceeds(t *testing.T) {
+	r := newReceiver(t, http.StatusNoContent)
+	w := NewWorker(nil, Config{AllowPrivateTargets: true})
+
+	attempt := w.attempt(context.Background(), testDelivery(r.URL, 0))
+	if !attempt.Succeeded() || attempt.StatusCode != http.StatusNoContent || attempt.NextAttemptAt != nil {
+		t.Errorf("attempt = %+v, want a success without a retry", attempt)
+	}
+	if attempt.Attempt != 1 || attempt.DeliveryID != 7 || attempt.WebhookID != "hook" {
+		t.Errorf("attempt = %+v, want attempt 1 of delivery 7 to hook", attempt)
+	}
+	if r.hits.Load() != 1 {
+		t.Errorf("receiver got %d requests, want 1", r.hits.Load())
+	}
+}
+
+func TestRetrySchedule(t *testing.T) {
+	r := newReceiver(t, http.StatusInternalServerError)
+	w := NewWorker(nil, Config{AllowPrivateTargets: true})
+
+	// DefaultRetryPolicy doubles from 10s up to 4h and gives up after attempt 15
+	want := []time.Duration{
+		10 * time.Second, 20 * time.Second, 40 * time.Second, 80 * time.Second, 160 * time.Second,
+		320 * time.Second, 640 * time.Second, 1280 * time.Second, 2560 * time.Second, 5120 * time.Second,
+		10240 * time.Second, 4 * time.Hour, 4 * time.Hour, 4 * time.Hour,
+	}
+	for n := 1; n <= DefaultRetryPolicy.MaxAttempts; n++ {
+		attempt := w.attempt(context.Background(), testDelivery(r.URL, n-1))
+		if attempt.Attempt != n || attempt.Succeeded() || attempt.StatusCode != http.StatusInternalServerError {
+			t.Fatalf("attempt %d = %+v, want a failed attempt %d", n, attempt, n)
+		}
+		if !strings.Contains(attempt.Error, "500") || !strings.Contains(attempt.Error, "receiver says") {
+			t.Errorf("attempt %d error = %q, want the status and body", n, attempt.Error)
+		}
+
+		if n == DefaultRetryPolicy.MaxAttempts {
+			if attempt.NextAttemptAt != nil {
+				t.Errorf("final attempt scheduled a retry at %s", attempt.NextAttemptAt)
+			}
+			continue
+		}
+		if attempt.NextAttemptAt == nil {
+			t.Fatalf("attempt %d scheduled no retry", n)
+		}
+		if got := attempt.NextAttemptAt.Sub(attempt.AttemptedAt); got != want[n-1] {
+			t.Errorf("retry after attempt %d in %s, want %s", n, got, want[n-1])
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := outbox.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempts, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 40: 5 * time.Second} {
+		if got := policy.Delay(attempts); got != want {
+			t.Errorf("Delay(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+	if policy.Exhausted(4) || !policy.Exhausted(5) {
+		t.Error("policy with MaxAttempts 5 should be exhausted at 5 attempts and not before")
+	}
+}
+
+func TestPrivateTargetsRefused(t *testing.T) {
+	r := newReceiver(t, http.StatusNoContent)
+	w := NewWorker(nil, Config{})
+
+	attempt := w.attempt(context.Background(), testDelivery(r.URL, 0))
+	if attempt.Succeeded() || attempt.StatusCode != 0 || !strings.Contains(attempt.Error, ErrPrivateTarget.Error()) {
+		t.Errorf("attempt = %+v, want a refused connection", attempt)
+	}
+	if r.hits.Load() != 0 {
+		t.Errorf("receiver on a loopback address got %d requests", r.hits.Load())
+	}
+}
+
+func TestFailingReceiverDisablesWebhook(t *testing.T) {
+	r := newReceiver(t, http.StatusServiceUnavailable)
+	workflow, err := models.NewWorkflow([]string{"todo", "done"}, []string{"done"}, []string{"*>*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := database.NewMemoryTaskStore(workflow)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hook := &models.Webhook{ID: "hook", URL: r.URL, Secret: testSecret, CreatedAt: time.Now()}
+	if err := store.CreateWebhook(ctx, hook); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if err := store.CreateTask(ctx, &models.Task{ID: "task", Title: "queued", CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	const failures = 3
+	w := NewWorker(store, Config{
+		Retry:        outbox.RetryPolicy{MaxAttempts: 100, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		Disable:      models.WebhookDisablePolicy{Failures: failures},
+		Concurrency:  1,
+		PollInterval: 5 * time.Millisecond,
+
+		AllowPrivateTargets: true,
+	})
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for disabled := false; !disabled; {
+		select {
+		case <-deadline:
+			t.Fatalf("webhook not disabled after %d failed attempts", r.hits.Load())
+		case <-time.After(5 * time.Millisecond):
+		}
+		hooks, err := store.ListWebhooks(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		disabled = hooks[0].DisabledAt != nil
+		hook = hooks[0]
+	}
+	// Give the worker a few more polls to show it stopped delivering
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+
+	if got := r.hits.Load(); got != failures {
+		t.Errorf("receiver got %d requests, want %d", got, failures)
+	}
+	if hook.FailureCount != failures || hook.DisabledReason == "" || !strings.Contains(hook.DisabledReason, "503") {
+		t.Errorf("disabled webhook = %+v, want %d failures and a reason naming the last error", hook, failures)
+	}
+
+	deliveries, err := store.ListWebhookDeliveries(context.Background(), &models.ListWebhookDeliveriesRequest{WebhookID: "hook", PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries.Attempts) != failures {
+		t.Fatalf("delivery log has %d attempts, want %d", len(deliveries.Attempts), failures)
+	}
+	for i, attempt := range deliveries.Attempts {
+		if want := failures - i; attempt.Attempt != want {
+			t.Errorf("log entry %d is attempt %d, want %d", i, attempt.Attempt, want)
+		}
+	}
+}
+
+func TestDisablePolicyPeriod(t *testing.T) {
+	hook := &models.Webhook{ID: "hook"}
+	policy := models.WebhookDisablePolicy{Failures: 2, Period: time.Hour}
+	start := time.Now()
+	fail := func(at time.Time) bool {
+		return hook.RecordAttempt(&models.WebhookAttempt{Error: "down", AttemptedAt: at}, policy)
+	}
+
+	if fail(start) || fail(start.Add(time.Minute)) {
+		t.Fatal("webhook disabled before failing for the whole period")
+	}
+	if !fail(start.Add(time.Hour)) {
+		t.Fatal("webhook not disabled after failing for the period")
+	}
+	if fail(start.Add(2 * time.Hour)) {
+		t.Error("an already disabled webhook was reported disabled again")
+	}
+
+	// A success resets the streak
+	hook = &models.Webhook{ID: "hook"}
+	fail(start)
+	hook.RecordAttempt(&models.WebhookAttempt{AttemptedAt: start.Add(time.Minute)}, policy)
+	if hook.FailureCount != 0 || hook.FailingSince != nil {
+		t.Errorf("success left failure count %d since %v", hook.FailureCount, hook.FailingSince)
+	}
+}